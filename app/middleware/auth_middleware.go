@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/pkg/auth"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// bearerPrefix - схема заголовка Authorization для JWT
+const bearerPrefix = "Bearer "
+
+// AuthMiddleware проверяет access токен запроса и находит его пользователя
+type AuthMiddleware struct {
+	tokens     *auth.TokenManager
+	principals *authorization.PrincipalCache
+}
+
+func NewAuthMiddleware(tokens *auth.TokenManager, principals *authorization.PrincipalCache) *AuthMiddleware {
+	return &AuthMiddleware{tokens: tokens, principals: principals}
+}
+
+// Authenticate требует заголовок "Authorization: Bearer <access token>"
+// Пользователь токена с текущими ролями сохраняется в контексте запроса (auth.GetPrincipal);
+// удаленный или заблокированный пользователь не проходит, даже если токен еще действует
+// Подключается один раз к группе маршрутов или к отдельному маршруту
+func (m *AuthMiddleware) Authenticate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get(fiber.HeaderAuthorization)
+		if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+			return domain_error.Unauthorized("auth.missing_token")
+		}
+
+		userID, err := m.tokens.ParseAccessToken(strings.TrimSpace(header[len(bearerPrefix):]))
+		if err != nil {
+			return domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+		}
+
+		principal, err := m.principals.Resolve(c.UserContext(), userID)
+		if err != nil {
+			return err
+		}
+
+		c.Locals(auth.PrincipalContextKey, principal)
+		return c.Next()
+	}
+}
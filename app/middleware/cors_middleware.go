@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/config"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// CorsMiddleware - CORS с разрешенными источниками из cors.allowOrigins
+// Список источников применяется на лету при изменении конфигурации
+type CorsMiddleware struct {
+	handler atomic.Pointer[fiber.Handler]
+}
+
+func NewCorsMiddleware(cfg *config.Config, watcher *config.Watcher) *CorsMiddleware {
+	m := &CorsMiddleware{}
+	m.update(cfg.Cors)
+	watcher.Subscribe(func(cfg *config.Config) {
+		m.update(cfg.Cors)
+	})
+	return m
+}
+
+// Handle возвращает middleware, делегирующий текущему обработчику CORS
+func (m *CorsMiddleware) Handle() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return (*m.handler.Load())(c)
+	}
+}
+
+// update пересоздает обработчик CORS, пустой список источников разрешает все ("*")
+func (m *CorsMiddleware) update(cfg config.CorsConfig) {
+	origins := "*"
+	if len(cfg.AllowOrigins) > 0 {
+		origins = strings.Join(cfg.AllowOrigins, ",")
+	}
+
+	handler := cors.New(cors.Config{
+		AllowOrigins:     origins,
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, Accept-Language, If-Match, " + HeaderReadYourWrites,
+		ExposeHeaders:    "ETag",
+		AllowCredentials: false,
+	})
+	m.handler.Store(&handler)
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PermissionMiddleware проверяет разрешения ролей аутентифицированного пользователя
+type PermissionMiddleware struct {
+	authorizer *authorization.Authorizer
+}
+
+func NewPermissionMiddleware(authorizer *authorization.Authorizer) *PermissionMiddleware {
+	return &PermissionMiddleware{authorizer: authorizer}
+}
+
+// RequirePermission пропускает запрос, только если одна из ролей пользователя дает разрешение permission
+// (например, "roles.edit"; manage включает остальные действия, см. authorization.Grants)
+// Подключается после AuthMiddleware.Authenticate к группе маршрутов или к отдельному маршруту
+func (m *PermissionMiddleware) RequirePermission(permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal, ok := auth.GetPrincipal(c)
+		if !ok {
+			return domain_error.Unauthorized("auth.missing_token")
+		}
+
+		allowed, err := m.authorizer.HasPermission(c.UserContext(), principal.Roles, permission)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return domain_error.Forbidden("auth.permission_denied").WithData(map[string]interface{}{
+				"Permission": permission,
+			})
+		}
+
+		return c.Next()
+	}
+}
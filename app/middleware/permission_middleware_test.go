@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// fakeRolePermissions - назначения разрешений ролям в памяти: role value -> permission values
+// Остальные методы RolePermissionRepository в тестах не вызываются
+type fakeRolePermissions struct {
+	repositories.RolePermissionRepository
+	roles map[string][]string
+}
+
+func (f fakeRolePermissions) HasPermission(ctx context.Context, roleValue string, permissionValue string) (bool, error) {
+	for _, permission := range f.roles[roleValue] {
+		if permission == permissionValue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f fakeRolePermissions) ListAll(ctx context.Context, params generated.ListAllRolePermissionsParams) ([]generated.ListAllRolePermissionsRow, error) {
+	var rows []generated.ListAllRolePermissionsRow
+	for role, permissions := range f.roles {
+		for _, permission := range permissions {
+			rows = append(rows, generated.ListAllRolePermissionsRow{
+				Role:       []byte(fmt.Sprintf(`{"value":%q}`, role)),
+				Permission: []byte(fmt.Sprintf(`{"value":%q}`, permission)),
+			})
+		}
+	}
+	return rows, nil
+}
+
+func newAuthorizer(repo repositories.RolePermissionRepository) *authorization.Authorizer {
+	return authorization.NewAuthorizer(authorization.NewPermissionCache(repo, repositories.NewRolePermissionEvents()))
+}
+
+func TestRequirePermission(t *testing.T) {
+	repo := fakeRolePermissions{roles: map[string][]string{
+		"role_editor":       {"roles.edit"},
+		"role_manager":      {"roles.manage"},
+		"editor":            {"edit"},
+		"admin":             {"manage"},
+		"permission_admin":  {"permissions.manage"},
+		"role_reader":       {"roles.read"},
+		"without_anything":  nil,
+		"roles_edit_prefix": {"roles.edit.extra"},
+	}}
+
+	tests := []struct {
+		name       string
+		principal  *auth.Principal
+		wantStatus int
+	}{
+		{name: "no principal", wantStatus: http.StatusUnauthorized},
+		{name: "no roles", principal: &auth.Principal{}, wantStatus: http.StatusForbidden},
+		{name: "exact permission", principal: &auth.Principal{Roles: []string{"role_editor"}}, wantStatus: http.StatusOK},
+		{name: "resource manage", principal: &auth.Principal{Roles: []string{"role_manager"}}, wantStatus: http.StatusOK},
+		{name: "action without resource", principal: &auth.Principal{Roles: []string{"editor"}}, wantStatus: http.StatusOK},
+		{name: "manage", principal: &auth.Principal{Roles: []string{"admin"}}, wantStatus: http.StatusOK},
+		{name: "other resource manage", principal: &auth.Principal{Roles: []string{"permission_admin"}}, wantStatus: http.StatusForbidden},
+		{name: "other action", principal: &auth.Principal{Roles: []string{"role_reader"}}, wantStatus: http.StatusForbidden},
+		{name: "role without permissions", principal: &auth.Principal{Roles: []string{"without_anything"}}, wantStatus: http.StatusForbidden},
+		{name: "not a prefix match", principal: &auth.Principal{Roles: []string{"roles_edit_prefix"}}, wantStatus: http.StatusForbidden},
+		{name: "unknown role", principal: &auth.Principal{Roles: []string{"ghost"}}, wantStatus: http.StatusForbidden},
+		{name: "any of several roles", principal: &auth.Principal{Roles: []string{"role_reader", "role_editor"}}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotErr error
+			app := fiber.New(fiber.Config{ErrorHandler: func(c *fiber.Ctx, err error) error {
+				gotErr = err
+				return c.SendStatus(domain_error.KindOf(err).HTTPStatus())
+			}})
+			app.Use(func(c *fiber.Ctx) error {
+				if tt.principal != nil {
+					c.Locals(auth.PrincipalContextKey, tt.principal)
+				}
+				return c.Next()
+			})
+			permissions := NewPermissionMiddleware(newAuthorizer(repo))
+			app.Put("/roles/:id", permissions.RequirePermission("roles.edit"), func(c *fiber.Ctx) error {
+				return c.SendStatus(http.StatusOK)
+			})
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodPut, "/roles/1", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d (error %v)", resp.StatusCode, tt.wantStatus, gotErr)
+			}
+			if tt.wantStatus == http.StatusForbidden {
+				if domainErr, ok := domain_error.As(gotErr); !ok || domainErr.MessageID != "auth.permission_denied" {
+					t.Errorf("error = %v, want auth.permission_denied", gotErr)
+				}
+			}
+		})
+	}
+}
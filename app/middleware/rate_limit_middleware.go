@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/domain/domain_error"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// RateLimitMiddleware ограничивает число запросов с одного IP (rateLimit.max за rateLimit.window)
+// Настройки применяются на лету; при изменении rateLimit счетчики начинаются заново
+type RateLimitMiddleware struct {
+	current atomic.Pointer[rateLimiter]
+}
+
+// rateLimiter - ограничитель и настройки, с которыми он создан
+type rateLimiter struct {
+	cfg     config.RateLimitConfig
+	handler fiber.Handler
+}
+
+func NewRateLimitMiddleware(cfg *config.Config, watcher *config.Watcher) *RateLimitMiddleware {
+	m := &RateLimitMiddleware{}
+	m.update(cfg.RateLimit)
+	watcher.Subscribe(func(cfg *config.Config) {
+		m.update(cfg.RateLimit)
+	})
+	return m
+}
+
+// Handle возвращает middleware, делегирующий текущему ограничителю
+func (m *RateLimitMiddleware) Handle() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return m.current.Load().handler(c)
+	}
+}
+
+// update пересоздает ограничитель, при rateLimit.enabled: false запросы пропускаются без проверки
+// Если rateLimit не изменился (перезагрузка затронула другие секции), счетчики сохраняются
+func (m *RateLimitMiddleware) update(cfg config.RateLimitConfig) {
+	if current := m.current.Load(); current != nil && current.cfg == cfg {
+		return
+	}
+
+	handler := func(c *fiber.Ctx) error {
+		return c.Next()
+	}
+	if cfg.Enabled {
+		handler = limiter.New(limiter.Config{
+			Max:        cfg.Max,
+			Expiration: cfg.Window,
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return c.IP()
+			},
+			LimitReached: func(c *fiber.Ctx) error {
+				return domain_error.RateLimited("")
+			},
+		})
+	}
+	m.current.Store(&rateLimiter{cfg: cfg, handler: handler})
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/domain/domain_error"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestRateLimitUpdate проверяет, что счетчики сбрасываются только при изменении rateLimit
+func TestRateLimitUpdate(t *testing.T) {
+	limited := config.RateLimitConfig{Enabled: true, Max: 1, Window: time.Hour}
+	tests := []struct {
+		name       string
+		next       config.RateLimitConfig
+		wantStatus int
+	}{
+		{name: "same settings keep counters", next: limited, wantStatus: http.StatusTooManyRequests},
+		{name: "changed max resets counters", next: config.RateLimitConfig{Enabled: true, Max: 2, Window: time.Hour}, wantStatus: http.StatusOK},
+		{name: "changed window resets counters", next: config.RateLimitConfig{Enabled: true, Max: 1, Window: time.Minute}, wantStatus: http.StatusOK},
+		{name: "disabled", next: config.RateLimitConfig{Max: 1, Window: time.Hour}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &RateLimitMiddleware{}
+			m.update(limited)
+
+			app := fiber.New(fiber.Config{ErrorHandler: func(c *fiber.Ctx, err error) error {
+				return c.SendStatus(domain_error.KindOf(err).HTTPStatus())
+			}})
+			app.Use(m.Handle())
+			app.Get("/", func(c *fiber.Ctx) error {
+				return c.SendStatus(http.StatusOK)
+			})
+			request := func() int {
+				resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return resp.StatusCode
+			}
+
+			if status := request(); status != http.StatusOK {
+				t.Fatalf("first request status = %d, want %d", status, http.StatusOK)
+			}
+			m.update(tt.next)
+			if status := request(); status != tt.wantStatus {
+				t.Errorf("status after update = %d, want %d", status, tt.wantStatus)
+			}
+		})
+	}
+}
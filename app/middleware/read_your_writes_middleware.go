@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/data/db"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderReadYourWrites - заголовок запроса, требующий читать данные с основной БД, а не с реплики
+const HeaderReadYourWrites = "X-Read-Your-Writes"
+
+// ReadYourWrites направляет все запросы к БД на основную БД для:
+//   - изменяющих запросов (POST, PUT, PATCH, DELETE): use case читает то, что только что записал
+//   - запросов с заголовком "X-Read-Your-Writes: true", например сразу после записи на клиенте
+//
+// Остальные запросы читают List*, Paginate*, Count*, Get*, Seek* с реплики (db.Router)
+func ReadYourWrites() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		primary, _ := strconv.ParseBool(c.Get(HeaderReadYourWrites))
+		if primary || !isSafeMethod(c.Method()) {
+			c.SetUserContext(db.WithPrimary(c.UserContext()))
+		}
+		return c.Next()
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"clean_architecture_fiber/config"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/logger"
+)
+
+// RequestLogMiddleware пишет HTTP запросы в stdout с учетом log.level:
+// debug и info - все запросы, warn - ответы 4xx и 5xx, error - только 5xx
+// Уровень применяется на лету при изменении конфигурации
+type RequestLogMiddleware struct {
+	minStatus atomic.Int32
+}
+
+func NewRequestLogMiddleware(cfg *config.Config, watcher *config.Watcher) *RequestLogMiddleware {
+	m := &RequestLogMiddleware{}
+	m.update(cfg.Log)
+	watcher.Subscribe(func(cfg *config.Config) {
+		m.update(cfg.Log)
+	})
+	return m
+}
+
+// Handle возвращает middleware логирования запросов
+func (m *RequestLogMiddleware) Handle() fiber.Handler {
+	return logger.New(logger.Config{
+		Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
+		TimeFormat: "2006-01-02 15:04:05",
+		TimeZone:   "Local",
+		// Строка формируется всегда, а в stdout попадает только при достаточном статусе ответа
+		Output: io.Discard,
+		Done: func(c *fiber.Ctx, logString []byte) {
+			if int32(c.Response().StatusCode()) >= m.minStatus.Load() {
+				_, _ = os.Stdout.Write(logString)
+			}
+		},
+	})
+}
+
+// update переводит уровень логирования в минимальный HTTP статус записываемых запросов
+func (m *RequestLogMiddleware) update(cfg config.LogConfig) {
+	switch strings.ToLower(cfg.Level) {
+	case "warn":
+		m.minStatus.Store(fiber.StatusBadRequest)
+	case "error":
+		m.minStatus.Store(fiber.StatusInternalServerError)
+	default:
+		m.minStatus.Store(0)
+	}
+}
@@ -0,0 +1,15 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterAuditRoutes регистрирует маршруты журнала изменений (только чтение)
+func RegisterAuditRoutes(app *fiber.App, auditHandler *handler.AuditHandler, authMiddleware *middleware.AuthMiddleware, permissionMiddleware *middleware.PermissionMiddleware) {
+	api := app.Group("/api/v1")
+	audit := api.Group("/audit", authMiddleware.Authenticate())
+	require := permissionMiddleware.RequirePermission
+	audit.Get("/", require("audit.read"), auditHandler.Paginate())
+}
@@ -0,0 +1,15 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterAuthRoutes(app *fiber.App, authHandler *handler.AuthHandler, authMiddleware *middleware.AuthMiddleware) {
+	api := app.Group("/api/v1")
+	authGroup := api.Group("/auth")
+	authGroup.Post("/login", authHandler.Login())
+	authGroup.Post("/refresh", authHandler.Refresh())
+	authGroup.Get("/me", authMiddleware.Authenticate(), authHandler.Me())
+}
@@ -0,0 +1,29 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterPermissionRoutes регистрирует маршруты разрешений и ролей, которым назначено разрешение
+func RegisterPermissionRoutes(app *fiber.App, permissionHandler *handler.PermissionHandler, rolePermissionHandler *handler.RolePermissionHandler, authMiddleware *middleware.AuthMiddleware, permissionMiddleware *middleware.PermissionMiddleware) {
+	api := app.Group("/api/v1")
+	permissions := api.Group("/permissions", authMiddleware.Authenticate())
+	require := permissionMiddleware.RequirePermission
+	permissions.Get("/", require("permissions.read"), permissionHandler.List())
+	permissions.Get("/paginate", require("permissions.read"), permissionHandler.Paginate())
+	permissions.Get("/trash", require("permissions.read"), permissionHandler.Trash())
+	permissions.Get("/trash/purge", require("permissions.read"), permissionHandler.PurgeReport())
+	permissions.Get("/export", require("permissions.read"), permissionHandler.Export())
+	permissions.Get("/id/:id", require("permissions.read"), permissionHandler.GetById())
+	permissions.Get("/:value", require("permissions.read"), permissionHandler.GetByValue())
+	permissions.Post("/", require("permissions.create"), permissionHandler.Create())
+	permissions.Post("/import", require("permissions.create"), require("permissions.edit"), permissionHandler.Import())
+	permissions.Put("/:id", require("permissions.edit"), permissionHandler.Update())
+	permissions.Delete("/:id", require("permissions.delete"), permissionHandler.Delete())
+	permissions.Patch("/:id/restore", require("permissions.edit"), permissionHandler.Restore())
+	permissions.Delete("/:id/hard", require("permissions.delete"), permissionHandler.HardDelete())
+
+	permissions.Get("/:id/roles", require("permissions.read"), rolePermissionHandler.GetPermissionRoles())
+}
@@ -0,0 +1,18 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRolePermissionRoutes регистрирует маршруты списка связей ролей и разрешений
+// Связи конкретной роли или разрешения регистрируются в группах /roles и /permissions (RegisterRoleRoutes, RegisterPermissionRoutes)
+func RegisterRolePermissionRoutes(app *fiber.App, rolePermissionHandler *handler.RolePermissionHandler, authMiddleware *middleware.AuthMiddleware, permissionMiddleware *middleware.PermissionMiddleware) {
+	api := app.Group("/api/v1")
+	require := permissionMiddleware.RequirePermission
+
+	rolePermissions := api.Group("/role-permissions", authMiddleware.Authenticate())
+	rolePermissions.Get("/", require("roles.read"), rolePermissionHandler.Paginate())
+	rolePermissions.Get("/check", require("roles.read"), rolePermissionHandler.Check())
+}
@@ -1,12 +1,32 @@
 package api_routing
 
 import (
+	"clean_architecture_fiber/app/middleware"
 	"clean_architecture_fiber/app/route/handler"
 	"github.com/gofiber/fiber/v2"
 )
 
-func RegisterRoleRoutes(app *fiber.App, roleHandler *handler.RoleHandler) {
+// RegisterRoleRoutes регистрирует маршруты ролей и разрешений, назначенных роли
+func RegisterRoleRoutes(app *fiber.App, roleHandler *handler.RoleHandler, rolePermissionHandler *handler.RolePermissionHandler, authMiddleware *middleware.AuthMiddleware, permissionMiddleware *middleware.PermissionMiddleware) {
 	api := app.Group("/api/v1")
-	roles := api.Group("/roles")
-	roles.Get("/:value", roleHandler.GetByValue)
+	roles := api.Group("/roles", authMiddleware.Authenticate())
+	require := permissionMiddleware.RequirePermission
+	roles.Get("/", require("roles.read"), roleHandler.List())
+	roles.Get("/paginate", require("roles.read"), roleHandler.Paginate())
+	roles.Get("/trash", require("roles.read"), roleHandler.Trash())
+	roles.Get("/trash/purge", require("roles.read"), roleHandler.PurgeReport())
+	roles.Get("/export", require("roles.read"), roleHandler.Export())
+	roles.Get("/id/:id", require("roles.read"), roleHandler.GetById())
+	roles.Get("/:value", require("roles.read"), roleHandler.GetByValue())
+	roles.Post("/", require("roles.create"), roleHandler.Create())
+	roles.Post("/import", require("roles.create"), require("roles.edit"), roleHandler.Import())
+	roles.Put("/:id", require("roles.edit"), roleHandler.Update())
+	roles.Delete("/:id", require("roles.delete"), roleHandler.Delete())
+	roles.Patch("/:id/restore", require("roles.edit"), roleHandler.Restore())
+	roles.Delete("/:id/hard", require("roles.delete"), roleHandler.HardDelete())
+
+	roles.Get("/:id/permissions", require("roles.read"), rolePermissionHandler.GetRolePermissions())
+	roles.Put("/:id/permissions", require("roles.edit"), rolePermissionHandler.ReplacePermissions())
+	roles.Post("/:id/permissions", require("roles.edit"), rolePermissionHandler.AssignPermissions())
+	roles.Delete("/:id/permissions", require("roles.edit"), rolePermissionHandler.RemovePermissions())
 }
@@ -0,0 +1,30 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterUserRoutes регистрирует маршруты пользователей и их ролей
+// Назначение ролей и смена email или пароля требуют users.manage: право изменять пользователя
+// не дает права выдавать роли или входить под чужой учетной записью
+func RegisterUserRoutes(app *fiber.App, userHandler *handler.UserHandler, userRoleHandler *handler.UserRoleHandler, authMiddleware *middleware.AuthMiddleware, permissionMiddleware *middleware.PermissionMiddleware) {
+	api := app.Group("/api/v1")
+	users := api.Group("/users", authMiddleware.Authenticate())
+	require := permissionMiddleware.RequirePermission
+	users.Get("/", require("users.read"), userHandler.Paginate())
+	users.Get("/:id", require("users.read"), userHandler.GetById())
+	users.Post("/", require("users.create"), userHandler.Create())
+	users.Put("/:id", require("users.edit"), userHandler.Update())
+	users.Put("/:id/credentials", require("users.manage"), userHandler.UpdateCredentials())
+	users.Delete("/:id", require("users.delete"), userHandler.Delete())
+	users.Patch("/:id/restore", require("users.edit"), userHandler.Restore())
+	users.Delete("/:id/hard", require("users.delete"), userHandler.HardDelete())
+
+	users.Get("/:id/roles", require("users.read"), userRoleHandler.GetUserRoles())
+	users.Put("/:id/roles", require("users.manage"), userRoleHandler.ReplaceRoles())
+	users.Post("/:id/roles", require("users.manage"), userRoleHandler.AssignRoles())
+	users.Delete("/:id/roles", require("users.manage"), userRoleHandler.RemoveRoles())
+	users.Get("/:id/permissions/check", require("users.read"), userRoleHandler.CheckPermission())
+}
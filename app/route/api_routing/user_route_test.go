@@ -0,0 +1,117 @@
+package api_routing
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/use_case/user_use_case"
+	"clean_architecture_fiber/pkg/auth"
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// fakeUsers - активные пользователи в памяти: ID -> значения ролей
+// Остальные методы UserRepository в тестах не вызываются
+type fakeUsers struct {
+	repositories.UserRepository
+	roles map[[16]byte][]string
+}
+
+func (f fakeUsers) GetById(ctx context.Context, id pgtype.UUID) (*generated.User, error) {
+	return &generated.User{ID: id, Email: "user@example.com", IsActive: true}, nil
+}
+
+func (f fakeUsers) GetRoleValues(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	return f.roles[userID.Bytes], nil
+}
+
+// fakeRolePermissions - назначения разрешений ролям в памяти: role value -> permission values
+type fakeRolePermissions struct {
+	repositories.RolePermissionRepository
+	roles map[string][]string
+}
+
+func (f fakeRolePermissions) ListAll(ctx context.Context, params generated.ListAllRolePermissionsParams) ([]generated.ListAllRolePermissionsRow, error) {
+	var rows []generated.ListAllRolePermissionsRow
+	for role, permissions := range f.roles {
+		for _, permission := range permissions {
+			rows = append(rows, generated.ListAllRolePermissionsRow{
+				Role:       []byte(fmt.Sprintf(`{"value":%q}`, role)),
+				Permission: []byte(fmt.Sprintf(`{"value":%q}`, permission)),
+			})
+		}
+	}
+	return rows, nil
+}
+
+// TestUserCredentialsRequireManage проверяет, что users.edit не позволяет сменить email или пароль
+// Пустое тело не проходит валидацию, поэтому 422 означает, что проверка разрешения пройдена
+func TestUserCredentialsRequireManage(t *testing.T) {
+	if err := i18nPkg.Init(); err != nil {
+		t.Fatalf("i18n.Init() error = %v", err)
+	}
+	editor, manager := uuid.New(), uuid.New()
+	users := fakeUsers{roles: map[[16]byte][]string{
+		editor:  {"user_editor"},
+		manager: {"user_manager"},
+	}}
+	rolePermissions := fakeRolePermissions{roles: map[string][]string{
+		"user_editor":  {"users.edit"},
+		"user_manager": {"users.manage"},
+	}}
+
+	tokens := auth.NewTokenManager("test", []byte("access"), []byte("refresh"), time.Minute, time.Hour)
+	events := repositories.NewRolePermissionEvents()
+	authMiddleware := middleware.NewAuthMiddleware(tokens, authorization.NewPrincipalCache(users, repositories.NewUserEvents(), events))
+	permissionMiddleware := middleware.NewPermissionMiddleware(authorization.NewAuthorizer(authorization.NewPermissionCache(rolePermissions, events)))
+	userHandler := &handler.UserHandler{
+		UpdateUserUC:        &user_use_case.UpdateUserUseCase{},
+		UpdateCredentialsUC: &user_use_case.UpdateUserCredentialsUseCase{},
+	}
+
+	app := fiber.New(fiber.Config{ErrorHandler: handler.ErrorHandler})
+	app.Use(i18nPkg.Middleware())
+	RegisterUserRoutes(app, userHandler, &handler.UserRoleHandler{}, authMiddleware, permissionMiddleware)
+
+	tests := []struct {
+		name       string
+		user       uuid.UUID
+		path       string
+		wantStatus int
+	}{
+		{name: "editor updates profile", user: editor, path: "", wantStatus: http.StatusUnprocessableEntity},
+		{name: "editor changes credentials", user: editor, path: "/credentials", wantStatus: http.StatusForbidden},
+		{name: "manager changes credentials", user: manager, path: "/credentials", wantStatus: http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pair, err := tokens.IssuePair(tt.user.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/"+uuid.NewString()+tt.path, strings.NewReader(`{}`))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+pair.AccessToken)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("PUT /api/v1/users/:id%s status = %d, want %d", tt.path, resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
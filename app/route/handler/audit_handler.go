@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/use_case/audit_use_case"
+	"clean_architecture_fiber/pkg/pagination"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditHandler struct {
+	PaginateAuditLogUC *audit_use_case.PaginateAuditLogUseCase
+}
+
+func NewAuditHandler(paginateAuditLogUC *audit_use_case.PaginateAuditLogUseCase) *AuditHandler {
+	return &AuditHandler{PaginateAuditLogUC: paginateAuditLogUC}
+}
+
+// GET /api/v1/audit
+func (h *AuditHandler) Paginate() fiber.Handler {
+	return HandleWithBinder[audit_use_case.PaginateAuditLogInput, *pagination.Page[dto.AuditLogRDTO]](h.PaginateAuditLogUC, auditLogBinder, http.StatusOK)
+}
+
+// auditLogBinder собирает параметры списка и период ?from=&to=
+func auditLogBinder(c *fiber.Ctx) (audit_use_case.PaginateAuditLogInput, error) {
+	return audit_use_case.PaginateAuditLogInput{
+		Query: pagination.Bind(c, audit_use_case.AuditLogPaginationOptions),
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+	}, nil
+}
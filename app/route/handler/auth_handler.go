@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/use_case/auth_use_case"
+	"clean_architecture_fiber/pkg/auth"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuthHandler struct {
+	LoginUC          *auth_use_case.LoginUseCase
+	RefreshTokenUC   *auth_use_case.RefreshTokenUseCase
+	GetCurrentUserUC *auth_use_case.GetCurrentUserUseCase
+}
+
+func NewAuthHandler(
+	loginUC *auth_use_case.LoginUseCase,
+	refreshTokenUC *auth_use_case.RefreshTokenUseCase,
+	getCurrentUserUC *auth_use_case.GetCurrentUserUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		LoginUC:          loginUC,
+		RefreshTokenUC:   refreshTokenUC,
+		GetCurrentUserUC: getCurrentUserUC,
+	}
+}
+
+// POST /api/v1/auth/login
+func (h *AuthHandler) Login() fiber.Handler {
+	return Handle[dto.LoginDTO, *auth.TokenPair](h.LoginUC, http.StatusOK)
+}
+
+// POST /api/v1/auth/refresh
+func (h *AuthHandler) Refresh() fiber.Handler {
+	return Handle[dto.RefreshTokenDTO, *auth.TokenPair](h.RefreshTokenUC, http.StatusOK)
+}
+
+// GET /api/v1/auth/me
+func (h *AuthHandler) Me() fiber.Handler {
+	return Handle[auth_use_case.GetCurrentUserInput, *dto.UserRDTO](h.GetCurrentUserUC, http.StatusOK)
+}
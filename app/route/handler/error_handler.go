@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorHandler - центральный обработчик ошибок Fiber
+// Доменные ошибки получают HTTP статус по категории и локализованное сообщение,
+// *fiber.Error сохраняет свой статус, все остальное считается внутренней ошибкой
+// Детали внутренних ошибок пишутся в лог и не отдаются клиенту
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	response := dto.ErrorRDTO{RequestID: c.GetRespHeader(fiber.HeaderXRequestID)}
+
+	var fiberErr *fiber.Error
+	if domainErr, ok := domain_error.As(err); ok {
+		response.Status = domainErr.Kind.HTTPStatus()
+		response.Code = string(domainErr.Kind)
+		response.Message = i18nPkg.MustTranslate(c, domainErr.Key(),
+			i18nPkg.Translate(c, domainErr.Kind.MessageID(), nil), domainErr.TemplateData)
+		// 412: клиент получает актуальное представление ресурса и его ETag
+		if domainErr.Current != nil {
+			response.Current = domainErr.Current
+			setETag(c, domainErr.Current)
+		}
+		for _, field := range domainErr.Fields {
+			response.Errors = append(response.Errors, dto.FieldErrorRDTO{
+				Field:   field.Field,
+				Rule:    field.Rule,
+				Message: i18nPkg.Translate(c, field.MessageID, field.TemplateData),
+			})
+		}
+	} else if errors.As(err, &fiberErr) && fiberErr.Code < http.StatusInternalServerError {
+		response.Status = fiberErr.Code
+		response.Code = statusCode(fiberErr.Code)
+		response.Message = fiberErr.Message
+	} else {
+		response.Status = http.StatusInternalServerError
+		response.Code = string(domain_error.KindInternal)
+	}
+
+	if response.Status >= http.StatusInternalServerError {
+		response.Code = string(domain_error.KindInternal)
+		response.Message = i18nPkg.Translate(c, domain_error.KindInternal.MessageID(), nil)
+		log.Printf("❌ %s %s [%s]: %v", c.Method(), c.Path(), response.RequestID, err)
+	}
+
+	return c.Status(response.Status).JSON(response)
+}
+
+// statusCode строит машиночитаемый код из HTTP статуса: 404 -> not_found
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/trash"
+	"clean_architecture_fiber/domain/use_case/permission_use_case"
+	"clean_architecture_fiber/domain/use_case/trash_use_case"
+	"clean_architecture_fiber/pkg/pagination"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PermissionHandler struct {
+	GetPermissionByValueUC *permission_use_case.GetPermissionByValueUseCase
+	GetPermissionByIdUC    *permission_use_case.GetPermissionByIdUseCase
+	CreatePermissionUC     *permission_use_case.CreatePermissionUseCase
+	UpdatePermissionUC     *permission_use_case.UpdatePermissionUseCase
+	DeletePermissionUC     *permission_use_case.DeletePermissionUseCase
+	RestorePermissionUC    *permission_use_case.RestorePermissionUseCase
+	HardDeletePermissionUC *permission_use_case.HardDeletePermissionUseCase
+	ListPermissionsUC      *permission_use_case.ListPermissionsUseCase
+	PaginatePermissionsUC  *permission_use_case.PaginatePermissionsUseCase
+	SeekPermissionsUC      *permission_use_case.SeekPermissionsUseCase
+	ImportPermissionsUC    *permission_use_case.ImportPermissionsUseCase
+	ExportPermissionsUC    *permission_use_case.ExportPermissionsUseCase
+	LastPurgeUC            *trash_use_case.GetLastPurgeUseCase
+}
+
+func NewPermissionHandler(
+	getUC *permission_use_case.GetPermissionByValueUseCase,
+	getByIdUC *permission_use_case.GetPermissionByIdUseCase,
+	createUC *permission_use_case.CreatePermissionUseCase,
+	updateUC *permission_use_case.UpdatePermissionUseCase,
+	deleteUC *permission_use_case.DeletePermissionUseCase,
+	restoreUC *permission_use_case.RestorePermissionUseCase,
+	hardDeleteUC *permission_use_case.HardDeletePermissionUseCase,
+	listUC *permission_use_case.ListPermissionsUseCase,
+	paginateUC *permission_use_case.PaginatePermissionsUseCase,
+	seekUC *permission_use_case.SeekPermissionsUseCase,
+	importUC *permission_use_case.ImportPermissionsUseCase,
+	exportUC *permission_use_case.ExportPermissionsUseCase,
+	lastPurgeUC *trash_use_case.GetLastPurgeUseCase,
+) *PermissionHandler {
+	return &PermissionHandler{
+		GetPermissionByValueUC: getUC,
+		GetPermissionByIdUC:    getByIdUC,
+		CreatePermissionUC:     createUC,
+		UpdatePermissionUC:     updateUC,
+		DeletePermissionUC:     deleteUC,
+		RestorePermissionUC:    restoreUC,
+		HardDeletePermissionUC: hardDeleteUC,
+		ListPermissionsUC:      listUC,
+		PaginatePermissionsUC:  paginateUC,
+		SeekPermissionsUC:      seekUC,
+		ImportPermissionsUC:    importUC,
+		ExportPermissionsUC:    exportUC,
+		LastPurgeUC:            lastPurgeUC,
+	}
+}
+
+// GET /api/v1/permissions/:value
+func (h *PermissionHandler) GetByValue() fiber.Handler {
+	return Handle[permission_use_case.GetPermissionByValueInput, *dto.PermissionRDTO](h.GetPermissionByValueUC, http.StatusOK)
+}
+
+// GET /api/v1/permissions/id/:id
+func (h *PermissionHandler) GetById() fiber.Handler {
+	return Handle[permission_use_case.GetPermissionByIdInput, *dto.PermissionRDTO](h.GetPermissionByIdUC, http.StatusOK)
+}
+
+// POST /api/v1/permissions
+func (h *PermissionHandler) Create() fiber.Handler {
+	return Handle[dto.PermissionDTO, *dto.PermissionRDTO](h.CreatePermissionUC, http.StatusCreated)
+}
+
+// PUT /api/v1/permissions/:id
+func (h *PermissionHandler) Update() fiber.Handler {
+	return Handle[permission_use_case.UpdatePermissionInput, *dto.PermissionRDTO](h.UpdatePermissionUC, http.StatusOK)
+}
+
+// DELETE /api/v1/permissions/:id
+func (h *PermissionHandler) Delete() fiber.Handler {
+	return Handle[permission_use_case.DeletePermissionInput, *dto.PermissionRDTO](h.DeletePermissionUC, http.StatusOK)
+}
+
+// PATCH /api/v1/permissions/:id/restore
+func (h *PermissionHandler) Restore() fiber.Handler {
+	return Handle[permission_use_case.RestorePermissionInput, *dto.PermissionRDTO](h.RestorePermissionUC, http.StatusOK)
+}
+
+// DELETE /api/v1/permissions/:id/hard
+func (h *PermissionHandler) HardDelete() fiber.Handler {
+	return Handle[permission_use_case.HardDeletePermissionInput, bool](h.HardDeletePermissionUC, http.StatusOK)
+}
+
+// GET /api/v1/permissions
+func (h *PermissionHandler) List() fiber.Handler {
+	return HandleWithBinder[pagination.Query, []dto.PermissionRDTO](h.ListPermissionsUC, PaginationBinder(permission_use_case.PermissionPaginationOptions), http.StatusOK)
+}
+
+// GET /api/v1/permissions/paginate
+// ?mode=cursor или ?cursor= переключает на keyset пагинацию
+func (h *PermissionHandler) Paginate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		input := pagination.Bind(c, permission_use_case.PermissionPaginationOptions)
+		if input.IsCursorMode() {
+			return Run[pagination.Query, *pagination.CursorPage[dto.PermissionRDTO]](c, h.SeekPermissionsUC, input, http.StatusOK)
+		}
+		return Run[pagination.Query, *pagination.Page[dto.PermissionRDTO]](c, h.PaginatePermissionsUC, input, http.StatusOK)
+	}
+}
+
+// GET /api/v1/permissions/trash
+// Корзина: только мягко удаленные записи, по умолчанию недавно удаленные первыми
+func (h *PermissionHandler) Trash() fiber.Handler {
+	return HandleWithBinder[pagination.Query, *pagination.Page[dto.PermissionRDTO]](h.PaginatePermissionsUC, TrashBinder(permission_use_case.PermissionPaginationOptions), http.StatusOK)
+}
+
+// GET /api/v1/permissions/trash/purge
+// Итог последней очистки корзины: число, ID и значения безвозвратно удаленных разрешений
+func (h *PermissionHandler) PurgeReport() fiber.Handler {
+	return HandleWithBinder[trash_use_case.GetLastPurgeInput, *dto.PurgeRunRDTO](h.LastPurgeUC, LastPurgeBinder(trash.TablePermissions), http.StatusOK)
+}
+
+// POST /api/v1/permissions/import
+// ?strategy=upsert|create|skip&validate_only=true, тело - JSON массив или CSV (Content-Type: text/csv)
+func (h *PermissionHandler) Import() fiber.Handler {
+	return HandleWithBinder[dto.ImportDTO, *dto.ImportReportRDTO](h.ImportPermissionsUC, ImportBinder, http.StatusOK)
+}
+
+// GET /api/v1/permissions/export?format=json|csv
+func (h *PermissionHandler) Export() fiber.Handler {
+	return Handle[dto.ExportDTO, *dto.FileRDTO](h.ExportPermissionsUC, http.StatusOK)
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/trash"
 	"clean_architecture_fiber/domain/use_case/role_use_case"
-	"context"
+	"clean_architecture_fiber/domain/use_case/trash_use_case"
+	"clean_architecture_fiber/pkg/pagination"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -10,27 +13,123 @@ import (
 
 type RoleHandler struct {
 	GetRoleByValueUC *role_use_case.GetRoleByValueUseCase
+	GetRoleByIdUC    *role_use_case.GetRoleByIdUseCase
+	CreateRoleUC     *role_use_case.CreateRoleUseCase
+	UpdateRoleUC     *role_use_case.UpdateRoleUseCase
+	DeleteRoleUC     *role_use_case.DeleteRoleUseCase
+	RestoreRoleUC    *role_use_case.RestoreRoleUseCase
+	HardDeleteRoleUC *role_use_case.HardDeleteRoleUseCase
+	ListRolesUC      *role_use_case.ListRolesUseCase
+	PaginateRolesUC  *role_use_case.PaginateRolesUseCase
+	SeekRolesUC      *role_use_case.SeekRolesUseCase
+	ImportRolesUC    *role_use_case.ImportRolesUseCase
+	ExportRolesUC    *role_use_case.ExportRolesUseCase
+	LastPurgeUC      *trash_use_case.GetLastPurgeUseCase
 }
 
-func NewRoleHandler(getUC *role_use_case.GetRoleByValueUseCase) *RoleHandler {
-	return &RoleHandler{GetRoleByValueUC: getUC}
+func NewRoleHandler(
+	getUC *role_use_case.GetRoleByValueUseCase,
+	getByIdUC *role_use_case.GetRoleByIdUseCase,
+	createUC *role_use_case.CreateRoleUseCase,
+	updateUC *role_use_case.UpdateRoleUseCase,
+	deleteUC *role_use_case.DeleteRoleUseCase,
+	restoreUC *role_use_case.RestoreRoleUseCase,
+	hardDeleteUC *role_use_case.HardDeleteRoleUseCase,
+	listUC *role_use_case.ListRolesUseCase,
+	paginateUC *role_use_case.PaginateRolesUseCase,
+	seekUC *role_use_case.SeekRolesUseCase,
+	importUC *role_use_case.ImportRolesUseCase,
+	exportUC *role_use_case.ExportRolesUseCase,
+	lastPurgeUC *trash_use_case.GetLastPurgeUseCase,
+) *RoleHandler {
+	return &RoleHandler{
+		GetRoleByValueUC: getUC,
+		GetRoleByIdUC:    getByIdUC,
+		CreateRoleUC:     createUC,
+		UpdateRoleUC:     updateUC,
+		DeleteRoleUC:     deleteUC,
+		RestoreRoleUC:    restoreUC,
+		HardDeleteRoleUC: hardDeleteUC,
+		ListRolesUC:      listUC,
+		PaginateRolesUC:  paginateUC,
+		SeekRolesUC:      seekUC,
+		ImportRolesUC:    importUC,
+		ExportRolesUC:    exportUC,
+		LastPurgeUC:      lastPurgeUC,
+	}
 }
 
 // GET /api/v1/roles/:value
-func (h *RoleHandler) GetByValue(c *fiber.Ctx) error {
-	value := c.Params("value")
-	if value == "" {
-		return fiber.NewError(http.StatusBadRequest, "role value is required")
-	}
+func (h *RoleHandler) GetByValue() fiber.Handler {
+	return Handle[role_use_case.GetRoleByValueInput, *dto.RoleRDTO](h.GetRoleByValueUC, http.StatusOK)
+}
 
-	result, err := h.GetRoleByValueUC.Execute(c, context.Background(), role_use_case.GetRoleByValueInput{Value: value})
-	if err != nil {
-		return fiber.NewError(http.StatusInternalServerError, err.Error())
-	}
+// GET /api/v1/roles/id/:id
+func (h *RoleHandler) GetById() fiber.Handler {
+	return Handle[role_use_case.GetRoleByIdInput, *dto.RoleRDTO](h.GetRoleByIdUC, http.StatusOK)
+}
+
+// POST /api/v1/roles
+func (h *RoleHandler) Create() fiber.Handler {
+	return Handle[dto.RoleDTO, *dto.RoleRDTO](h.CreateRoleUC, http.StatusCreated)
+}
+
+// PUT /api/v1/roles/:id
+func (h *RoleHandler) Update() fiber.Handler {
+	return Handle[role_use_case.UpdateRoleInput, *dto.RoleRDTO](h.UpdateRoleUC, http.StatusOK)
+}
+
+// DELETE /api/v1/roles/:id
+func (h *RoleHandler) Delete() fiber.Handler {
+	return Handle[role_use_case.DeleteRoleInput, *dto.RoleRDTO](h.DeleteRoleUC, http.StatusOK)
+}
+
+// PATCH /api/v1/roles/:id/restore
+func (h *RoleHandler) Restore() fiber.Handler {
+	return Handle[role_use_case.RestoreRoleInput, *dto.RoleRDTO](h.RestoreRoleUC, http.StatusOK)
+}
+
+// DELETE /api/v1/roles/:id/hard
+func (h *RoleHandler) HardDelete() fiber.Handler {
+	return Handle[role_use_case.HardDeleteRoleInput, bool](h.HardDeleteRoleUC, http.StatusOK)
+}
 
-	if result == nil {
-		return fiber.NewError(http.StatusNotFound, "role not found")
+// GET /api/v1/roles
+func (h *RoleHandler) List() fiber.Handler {
+	return HandleWithBinder[pagination.Query, []dto.RoleRDTO](h.ListRolesUC, PaginationBinder(role_use_case.RolePaginationOptions), http.StatusOK)
+}
+
+// GET /api/v1/roles/paginate
+// ?mode=cursor или ?cursor= переключает на keyset пагинацию
+func (h *RoleHandler) Paginate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		input := pagination.Bind(c, role_use_case.RolePaginationOptions)
+		if input.IsCursorMode() {
+			return Run[pagination.Query, *pagination.CursorPage[dto.RoleRDTO]](c, h.SeekRolesUC, input, http.StatusOK)
+		}
+		return Run[pagination.Query, *pagination.Page[dto.RoleRDTO]](c, h.PaginateRolesUC, input, http.StatusOK)
 	}
+}
+
+// GET /api/v1/roles/trash
+// Корзина: только мягко удаленные записи, по умолчанию недавно удаленные первыми
+func (h *RoleHandler) Trash() fiber.Handler {
+	return HandleWithBinder[pagination.Query, *pagination.Page[dto.RoleRDTO]](h.PaginateRolesUC, TrashBinder(role_use_case.RolePaginationOptions), http.StatusOK)
+}
+
+// GET /api/v1/roles/trash/purge
+// Итог последней очистки корзины: число, ID и значения безвозвратно удаленных ролей
+func (h *RoleHandler) PurgeReport() fiber.Handler {
+	return HandleWithBinder[trash_use_case.GetLastPurgeInput, *dto.PurgeRunRDTO](h.LastPurgeUC, LastPurgeBinder(trash.TableRoles), http.StatusOK)
+}
+
+// POST /api/v1/roles/import
+// ?strategy=upsert|create|skip&validate_only=true, тело - JSON массив или CSV (Content-Type: text/csv)
+func (h *RoleHandler) Import() fiber.Handler {
+	return HandleWithBinder[dto.ImportDTO, *dto.ImportReportRDTO](h.ImportRolesUC, ImportBinder, http.StatusOK)
+}
 
-	return c.Status(http.StatusOK).JSON(result)
+// GET /api/v1/roles/export?format=json|csv
+func (h *RoleHandler) Export() fiber.Handler {
+	return Handle[dto.ExportDTO, *dto.FileRDTO](h.ExportRolesUC, http.StatusOK)
 }
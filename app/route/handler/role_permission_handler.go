@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/use_case/role_permission_use_case"
+	"clean_architecture_fiber/pkg/pagination"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RolePermissionHandler struct {
+	GetRolePermissionsUC        *role_permission_use_case.GetRolePermissionsUseCase
+	GetPermissionRolesUC        *role_permission_use_case.GetPermissionRolesUseCase
+	AssignPermissionsToRoleUC   *role_permission_use_case.AssignPermissionsToRoleUseCase
+	RemovePermissionsFromRoleUC *role_permission_use_case.RemovePermissionsFromRoleUseCase
+	ReplaceRolePermissionsUC    *role_permission_use_case.ReplaceRolePermissionsUseCase
+	CheckRoleHasPermissionUC    *role_permission_use_case.CheckRoleHasPermissionUseCase
+	PaginateRolePermissionsUC   *role_permission_use_case.PaginateRolePermissionsUseCase
+	SeekRolePermissionsUC       *role_permission_use_case.SeekRolePermissionsUseCase
+}
+
+func NewRolePermissionHandler(
+	getRolePermissionsUC *role_permission_use_case.GetRolePermissionsUseCase,
+	getPermissionRolesUC *role_permission_use_case.GetPermissionRolesUseCase,
+	assignUC *role_permission_use_case.AssignPermissionsToRoleUseCase,
+	removeUC *role_permission_use_case.RemovePermissionsFromRoleUseCase,
+	replaceUC *role_permission_use_case.ReplaceRolePermissionsUseCase,
+	checkUC *role_permission_use_case.CheckRoleHasPermissionUseCase,
+	paginateUC *role_permission_use_case.PaginateRolePermissionsUseCase,
+	seekUC *role_permission_use_case.SeekRolePermissionsUseCase,
+) *RolePermissionHandler {
+	return &RolePermissionHandler{
+		GetRolePermissionsUC:        getRolePermissionsUC,
+		GetPermissionRolesUC:        getPermissionRolesUC,
+		AssignPermissionsToRoleUC:   assignUC,
+		RemovePermissionsFromRoleUC: removeUC,
+		ReplaceRolePermissionsUC:    replaceUC,
+		CheckRoleHasPermissionUC:    checkUC,
+		PaginateRolePermissionsUC:   paginateUC,
+		SeekRolePermissionsUC:       seekUC,
+	}
+}
+
+// GET /api/v1/roles/:id/permissions
+func (h *RolePermissionHandler) GetRolePermissions() fiber.Handler {
+	return Handle[role_permission_use_case.GetRolePermissionsInput, []dto.PermissionRDTO](h.GetRolePermissionsUC, http.StatusOK)
+}
+
+// GET /api/v1/permissions/:id/roles
+func (h *RolePermissionHandler) GetPermissionRoles() fiber.Handler {
+	return Handle[role_permission_use_case.GetPermissionRolesInput, []dto.RoleRDTO](h.GetPermissionRolesUC, http.StatusOK)
+}
+
+// POST /api/v1/roles/:id/permissions
+func (h *RolePermissionHandler) AssignPermissions() fiber.Handler {
+	return Handle[role_permission_use_case.ChangeRolePermissionsInput, []dto.PermissionRDTO](h.AssignPermissionsToRoleUC, http.StatusOK)
+}
+
+// DELETE /api/v1/roles/:id/permissions
+func (h *RolePermissionHandler) RemovePermissions() fiber.Handler {
+	return Handle[role_permission_use_case.ChangeRolePermissionsInput, []dto.PermissionRDTO](h.RemovePermissionsFromRoleUC, http.StatusOK)
+}
+
+// PUT /api/v1/roles/:id/permissions
+func (h *RolePermissionHandler) ReplacePermissions() fiber.Handler {
+	return Handle[role_permission_use_case.ChangeRolePermissionsInput, []dto.PermissionRDTO](h.ReplaceRolePermissionsUC, http.StatusOK)
+}
+
+// GET /api/v1/role-permissions/check?role=admin&permission=read
+func (h *RolePermissionHandler) Check() fiber.Handler {
+	return Handle[role_permission_use_case.CheckRoleHasPermissionInput, *dto.RolePermissionCheckRDTO](h.CheckRoleHasPermissionUC, http.StatusOK)
+}
+
+// GET /api/v1/role-permissions
+// ?mode=cursor или ?cursor= переключает на keyset пагинацию
+func (h *RolePermissionHandler) Paginate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		input := pagination.Bind(c, role_permission_use_case.RolePermissionPaginationOptions)
+		if input.IsCursorMode() {
+			return Run[pagination.Query, *pagination.CursorPage[dto.RolePermissionRDTO]](c, h.SeekRolePermissionsUC, input, http.StatusOK)
+		}
+		return Run[pagination.Query, *pagination.Page[dto.RolePermissionRDTO]](c, h.PaginateRolePermissionsUC, input, http.StatusOK)
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/transfer"
+	"clean_architecture_fiber/domain/use_case"
+	"clean_architecture_fiber/domain/use_case/trash_use_case"
+	"clean_architecture_fiber/pkg/pagination"
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Binder собирает входные данные use case из HTTP запроса
+type Binder[In any] func(c *fiber.Ctx) (In, error)
+
+// Handle превращает use case в fiber.Handler
+// Входные данные собираются BindRequest из параметров пути, query строки и тела запроса
+func Handle[In any, Out any](uc use_case.UseCase[In, Out], status int) fiber.Handler {
+	return HandleWithBinder(uc, BindRequest[In], status)
+}
+
+// HandleWithBinder превращает use case в fiber.Handler с собственным способом сборки входных данных
+func HandleWithBinder[In any, Out any](uc use_case.UseCase[In, Out], bind Binder[In], status int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		input, err := bind(c)
+		if err != nil {
+			return badRequest(err)
+		}
+		return Run(c, uc, input, status)
+	}
+}
+
+// Run выполняет конвейер use case для уже собранных входных данных: Validate -> Execute -> Transform
+// Доменные ошибки и *fiber.Error пробрасываются как есть и отрисовываются ErrorHandler
+// Прочие ошибки Validate считаются ошибкой запроса (400), ошибки Execute и Transform - внутренними (500)
+func Run[In any, Out any](c *fiber.Ctx, uc use_case.UseCase[In, Out], input In, status int) error {
+	ctx := c.UserContext()
+
+	if err := uc.Validate(c, ctx, input); err != nil {
+		return badRequest(err)
+	}
+
+	result, err := uc.Execute(c, ctx, input)
+	if err != nil {
+		return internal(err)
+	}
+
+	response, err := uc.Transform(c, ctx, result)
+	if err != nil {
+		return internal(err)
+	}
+	if file, ok := response.(Downloadable); ok {
+		return sendFile(c, file, status)
+	}
+	setETag(c, response)
+
+	return c.Status(status).JSON(response)
+}
+
+// Downloadable - ответ в виде файла, Run отдает его как вложение вместо JSON
+type Downloadable interface {
+	Download() (name string, contentType string, content []byte)
+}
+
+// sendFile отдает файл с заголовками Content-Type и Content-Disposition: attachment
+func sendFile(c *fiber.Ctx, file Downloadable, status int) error {
+	name, contentType, content := file.Download()
+	c.Attachment(name)
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Status(status).Send(content)
+}
+
+// Versioned - ответ с версией ресурса, Run отдает ее в заголовке ETag (см. If-Match)
+type Versioned interface {
+	ETag() string
+}
+
+// setETag выставляет заголовок ETag, если ответ несет версию ресурса
+func setETag(c *fiber.Ctx, response any) {
+	if versioned, ok := response.(Versioned); ok {
+		c.Set(fiber.HeaderETag, versioned.ETag())
+	}
+}
+
+// BindRequest заполняет структуру входных данных из запроса:
+//   - параметры пути по тегу `params`
+//   - query строку по тегу `query`
+//   - заголовки запроса в строковые поля по тегу `header` (например, `header:"If-Match"`)
+//   - тело запроса в поле с тегом `body:"true"`, а если такого поля нет - в саму структуру
+//
+// Для входных данных, не являющихся структурой, возвращается нулевое значение
+func BindRequest[In any](c *fiber.Ctx) (In, error) {
+	var input In
+
+	value := reflect.ValueOf(&input).Elem()
+	if value.Kind() != reflect.Struct {
+		return input, nil
+	}
+
+	if err := c.ParamsParser(&input); err != nil {
+		return input, err
+	}
+	if err := c.QueryParser(&input); err != nil {
+		return input, err
+	}
+	bindHeaders(c, value)
+
+	if len(c.Body()) == 0 {
+		return input, nil
+	}
+
+	target := any(&input)
+	if field, ok := bodyField(value); ok {
+		target = field.Addr().Interface()
+	}
+	if err := c.BodyParser(target); err != nil {
+		return input, err
+	}
+
+	return input, nil
+}
+
+// PaginationBinder собирает pagination.Query по allow-list сущности
+func PaginationBinder(opts pagination.Options) Binder[pagination.Query] {
+	return func(c *fiber.Ctx) (pagination.Query, error) {
+		return pagination.Bind(c, opts), nil
+	}
+}
+
+// LastPurgeBinder задает таблицу, для которой возвращается итог очистки корзины
+func LastPurgeBinder(table string) Binder[trash_use_case.GetLastPurgeInput] {
+	return func(c *fiber.Ctx) (trash_use_case.GetLastPurgeInput, error) {
+		return trash_use_case.GetLastPurgeInput{Table: table}, nil
+	}
+}
+
+// TrashBinder собирает pagination.Query корзины (только мягко удаленные записи)
+func TrashBinder(opts pagination.Options) Binder[pagination.Query] {
+	return func(c *fiber.Ctx) (pagination.Query, error) {
+		return pagination.BindTrash(c, opts), nil
+	}
+}
+
+// ImportBinder собирает dto.ImportDTO: параметры из query строки, строки из тела запроса
+// Формат тела задается параметром format или заголовком Content-Type: text/csv (по умолчанию JSON)
+func ImportBinder(c *fiber.Ctx) (dto.ImportDTO, error) {
+	var input dto.ImportDTO
+	if err := c.QueryParser(&input); err != nil {
+		return input, err
+	}
+	format, err := transfer.FormatOf(c.Query("format"), c.Get(fiber.HeaderContentType))
+	if err != nil {
+		return input, err
+	}
+	input.Rows, err = transfer.Decode(format, c.Body())
+	return input, err
+}
+
+// bindHeaders заполняет строковые поля с тегом `header` значениями заголовков запроса
+// Поля без тега не заполняются, в отличие от c.ReqHeaderParser
+func bindHeaders(c *fiber.Ctx, value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		name := value.Type().Field(i).Tag.Get("header")
+		if name != "" && value.Field(i).Kind() == reflect.String {
+			value.Field(i).SetString(c.Get(name))
+		}
+	}
+}
+
+// bodyField ищет поле структуры, помеченное тегом `body:"true"`
+func bodyField(value reflect.Value) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("body") == "true" {
+			return value.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// badRequest оборачивает ошибку разбора или проверки запроса в 400
+func badRequest(err error) error {
+	if isTyped(err) {
+		return err
+	}
+	return fiber.NewError(http.StatusBadRequest, err.Error())
+}
+
+// internal оборачивает непредвиденную ошибку выполнения во внутреннюю доменную ошибку
+func internal(err error) error {
+	if isTyped(err) {
+		return err
+	}
+	return domain_error.Internal(err)
+}
+
+// isTyped сообщает, несет ли ошибка собственный HTTP статус
+func isTyped(err error) bool {
+	if _, ok := domain_error.As(err); ok {
+		return true
+	}
+	var fiberErr *fiber.Error
+	return errors.As(err, &fiberErr)
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/validation"
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type bindBody struct {
+	Value string `json:"value" validate:"required"`
+	Title string `json:"title" validate:"min=3"`
+}
+
+type bindInput struct {
+	ID      string   `params:"id"`
+	Limit   int      `query:"limit"`
+	IfMatch string   `header:"If-Match"`
+	Data    bindBody `body:"true"`
+}
+
+type flatInput struct {
+	ID    string `params:"id"`
+	Value string `json:"value"`
+}
+
+// echoUseCase проверяет входные данные validation.Struct и возвращает их без изменений
+type echoUseCase[In any] struct{}
+
+func (echoUseCase[In]) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input In) error {
+	return validation.Struct(input)
+}
+
+func (echoUseCase[In]) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input In) (In, error) {
+	return input, nil
+}
+
+func (echoUseCase[In]) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result In) (any, error) {
+	return result, nil
+}
+
+func newTestApp(t *testing.T) *fiber.App {
+	t.Helper()
+	if err := i18nPkg.Init(); err != nil {
+		t.Fatalf("i18n.Init() error = %v", err)
+	}
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(i18nPkg.Middleware())
+	return app
+}
+
+func TestBindRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		header  string
+		body    string
+		bind    func(c *fiber.Ctx) (any, error)
+		want    any
+		wantErr bool
+	}{
+		{
+			name:   "params, query and body field",
+			target: "/items/42?limit=5",
+			body:   `{"value":"admin","title":"Admin"}`,
+			bind:   func(c *fiber.Ctx) (any, error) { return BindRequest[bindInput](c) },
+			want:   bindInput{ID: "42", Limit: 5, Data: bindBody{Value: "admin", Title: "Admin"}},
+		},
+		{
+			name:   "header",
+			target: "/items/42",
+			header: `"v1"`,
+			bind:   func(c *fiber.Ctx) (any, error) { return BindRequest[bindInput](c) },
+			want:   bindInput{ID: "42", IfMatch: `"v1"`},
+		},
+		{
+			name:   "empty body",
+			target: "/items/42",
+			bind:   func(c *fiber.Ctx) (any, error) { return BindRequest[bindInput](c) },
+			want:   bindInput{ID: "42"},
+		},
+		{
+			name:   "body into the struct itself",
+			target: "/items/42",
+			body:   `{"value":"admin"}`,
+			bind:   func(c *fiber.Ctx) (any, error) { return BindRequest[flatInput](c) },
+			want:   flatInput{ID: "42", Value: "admin"},
+		},
+		{
+			name:   "not a struct",
+			target: "/items/42",
+			body:   `{"value":"admin"}`,
+			bind:   func(c *fiber.Ctx) (any, error) { return BindRequest[string](c) },
+			want:   "",
+		},
+		{
+			name:    "malformed body",
+			target:  "/items/42",
+			body:    `{"value":`,
+			bind:    func(c *fiber.Ctx) (any, error) { return BindRequest[bindInput](c) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got any
+			var err error
+			app := fiber.New()
+			app.Post("/items/:id", func(c *fiber.Ctx) error {
+				got, err = tt.bind(c)
+				return nil
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			if tt.header != "" {
+				req.Header.Set(fiber.HeaderIfMatch, tt.header)
+			}
+			if _, testErr := app.Test(req); testErr != nil {
+				t.Fatal(testErr)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("BindRequest() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BindRequest() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BindRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleValidationErrors(t *testing.T) {
+	tests := []struct {
+		lang       string
+		wantFields []dto.FieldErrorRDTO
+	}{
+		{
+			lang: i18nPkg.LangRu,
+			wantFields: []dto.FieldErrorRDTO{
+				{Field: "value", Rule: "required", Message: "Поле value обязательно для заполнения"},
+				{Field: "title", Rule: "min", Message: "Поле title должно содержать минимум 3 символов"},
+			},
+		},
+		{
+			lang: i18nPkg.LangEn,
+			wantFields: []dto.FieldErrorRDTO{
+				{Field: "value", Rule: "required", Message: "Field value is required"},
+				{Field: "title", Rule: "min", Message: "Field title must contain at least 3 characters"},
+			},
+		},
+		{
+			lang: i18nPkg.LangKk,
+			wantFields: []dto.FieldErrorRDTO{
+				{Field: "value", Rule: "required", Message: "value өрісін толтыру міндетті"},
+				{Field: "title", Rule: "min", Message: "title өрісі кемінде 3 таңбадан тұруы керек"},
+			},
+		},
+	}
+	app := newTestApp(t)
+	app.Post("/items/:id", Handle[bindInput, bindInput](echoUseCase[bindInput]{}, fiber.StatusCreated))
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/items/42", strings.NewReader(`{"title":"ab"}`))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			req.Header.Set(fiber.HeaderAcceptLanguage, tt.lang)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var body dto.ErrorRDTO
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if resp.StatusCode != http.StatusUnprocessableEntity || body.Status != http.StatusUnprocessableEntity || body.Code != "validation" {
+				t.Errorf("response = %d %+v, want 422 validation", resp.StatusCode, body)
+			}
+			if want := i18nPkg.T(tt.lang, "error.validation", nil); body.Message != want {
+				t.Errorf("message = %q, want %q", body.Message, want)
+			}
+			if !reflect.DeepEqual(body.Errors, tt.wantFields) {
+				t.Errorf("errors = %+v, want %+v", body.Errors, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/use_case/user_use_case"
+	"clean_architecture_fiber/pkg/pagination"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserHandler struct {
+	GetUserByIdUC       *user_use_case.GetUserByIdUseCase
+	CreateUserUC        *user_use_case.CreateUserUseCase
+	UpdateUserUC        *user_use_case.UpdateUserUseCase
+	UpdateCredentialsUC *user_use_case.UpdateUserCredentialsUseCase
+	DeleteUserUC        *user_use_case.DeleteUserUseCase
+	RestoreUserUC       *user_use_case.RestoreUserUseCase
+	HardDeleteUserUC    *user_use_case.HardDeleteUserUseCase
+	PaginateUsersUC     *user_use_case.PaginateUsersUseCase
+}
+
+func NewUserHandler(
+	getUserByIdUC *user_use_case.GetUserByIdUseCase,
+	createUserUC *user_use_case.CreateUserUseCase,
+	updateUserUC *user_use_case.UpdateUserUseCase,
+	updateCredentialsUC *user_use_case.UpdateUserCredentialsUseCase,
+	deleteUserUC *user_use_case.DeleteUserUseCase,
+	restoreUserUC *user_use_case.RestoreUserUseCase,
+	hardDeleteUserUC *user_use_case.HardDeleteUserUseCase,
+	paginateUsersUC *user_use_case.PaginateUsersUseCase,
+) *UserHandler {
+	return &UserHandler{
+		GetUserByIdUC:       getUserByIdUC,
+		CreateUserUC:        createUserUC,
+		UpdateUserUC:        updateUserUC,
+		UpdateCredentialsUC: updateCredentialsUC,
+		DeleteUserUC:        deleteUserUC,
+		RestoreUserUC:       restoreUserUC,
+		HardDeleteUserUC:    hardDeleteUserUC,
+		PaginateUsersUC:     paginateUsersUC,
+	}
+}
+
+// GET /api/v1/users/:id
+func (h *UserHandler) GetById() fiber.Handler {
+	return Handle[user_use_case.GetUserByIdInput, *dto.UserRDTO](h.GetUserByIdUC, http.StatusOK)
+}
+
+// POST /api/v1/users
+func (h *UserHandler) Create() fiber.Handler {
+	return Handle[dto.CreateUserDTO, *dto.UserRDTO](h.CreateUserUC, http.StatusCreated)
+}
+
+// PUT /api/v1/users/:id
+func (h *UserHandler) Update() fiber.Handler {
+	return Handle[user_use_case.UpdateUserInput, *dto.UserRDTO](h.UpdateUserUC, http.StatusOK)
+}
+
+// PUT /api/v1/users/:id/credentials
+func (h *UserHandler) UpdateCredentials() fiber.Handler {
+	return Handle[user_use_case.UpdateUserCredentialsInput, *dto.UserRDTO](h.UpdateCredentialsUC, http.StatusOK)
+}
+
+// DELETE /api/v1/users/:id
+func (h *UserHandler) Delete() fiber.Handler {
+	return Handle[user_use_case.DeleteUserInput, *dto.UserRDTO](h.DeleteUserUC, http.StatusOK)
+}
+
+// PATCH /api/v1/users/:id/restore
+func (h *UserHandler) Restore() fiber.Handler {
+	return Handle[user_use_case.RestoreUserInput, *dto.UserRDTO](h.RestoreUserUC, http.StatusOK)
+}
+
+// DELETE /api/v1/users/:id/hard
+func (h *UserHandler) HardDelete() fiber.Handler {
+	return Handle[user_use_case.HardDeleteUserInput, bool](h.HardDeleteUserUC, http.StatusOK)
+}
+
+// GET /api/v1/users
+func (h *UserHandler) Paginate() fiber.Handler {
+	return HandleWithBinder[pagination.Query, *pagination.Page[dto.UserRDTO]](h.PaginateUsersUC, PaginationBinder(user_use_case.UserPaginationOptions), http.StatusOK)
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/use_case/user_role_use_case"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserRoleHandler struct {
+	GetUserRolesUC           *user_role_use_case.GetUserRolesUseCase
+	AssignRolesToUserUC      *user_role_use_case.AssignRolesToUserUseCase
+	RemoveRolesFromUserUC    *user_role_use_case.RemoveRolesFromUserUseCase
+	ReplaceUserRolesUC       *user_role_use_case.ReplaceUserRolesUseCase
+	CheckUserHasPermissionUC *user_role_use_case.CheckUserHasPermissionUseCase
+}
+
+func NewUserRoleHandler(
+	getUserRolesUC *user_role_use_case.GetUserRolesUseCase,
+	assignUC *user_role_use_case.AssignRolesToUserUseCase,
+	removeUC *user_role_use_case.RemoveRolesFromUserUseCase,
+	replaceUC *user_role_use_case.ReplaceUserRolesUseCase,
+	checkUC *user_role_use_case.CheckUserHasPermissionUseCase,
+) *UserRoleHandler {
+	return &UserRoleHandler{
+		GetUserRolesUC:           getUserRolesUC,
+		AssignRolesToUserUC:      assignUC,
+		RemoveRolesFromUserUC:    removeUC,
+		ReplaceUserRolesUC:       replaceUC,
+		CheckUserHasPermissionUC: checkUC,
+	}
+}
+
+// GET /api/v1/users/:id/roles
+func (h *UserRoleHandler) GetUserRoles() fiber.Handler {
+	return Handle[user_role_use_case.GetUserRolesInput, []dto.RoleRDTO](h.GetUserRolesUC, http.StatusOK)
+}
+
+// POST /api/v1/users/:id/roles
+func (h *UserRoleHandler) AssignRoles() fiber.Handler {
+	return Handle[user_role_use_case.ChangeUserRolesInput, []dto.RoleRDTO](h.AssignRolesToUserUC, http.StatusOK)
+}
+
+// DELETE /api/v1/users/:id/roles
+func (h *UserRoleHandler) RemoveRoles() fiber.Handler {
+	return Handle[user_role_use_case.ChangeUserRolesInput, []dto.RoleRDTO](h.RemoveRolesFromUserUC, http.StatusOK)
+}
+
+// PUT /api/v1/users/:id/roles
+func (h *UserRoleHandler) ReplaceRoles() fiber.Handler {
+	return Handle[user_role_use_case.ChangeUserRolesInput, []dto.RoleRDTO](h.ReplaceUserRolesUC, http.StatusOK)
+}
+
+// GET /api/v1/users/:id/permissions/check?permission=roles.edit
+func (h *UserRoleHandler) CheckPermission() fiber.Handler {
+	return Handle[user_role_use_case.CheckUserHasPermissionInput, *dto.UserPermissionCheckRDTO](h.CheckUserHasPermissionUC, http.StatusOK)
+}
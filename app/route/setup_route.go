@@ -1,12 +1,29 @@
 package route
 
 import (
+	"clean_architecture_fiber/app/middleware"
 	"clean_architecture_fiber/app/route/api_routing"
 	"clean_architecture_fiber/app/route/handler"
 	"github.com/gofiber/fiber/v2"
 )
 
 // setupRoutes настраивает маршруты API
-func SetupRoutes(app *fiber.App, roleHandler *handler.RoleHandler) {
-	api_routing.RegisterRoleRoutes(app, roleHandler)
+func SetupRoutes(
+	app *fiber.App,
+	roleHandler *handler.RoleHandler,
+	permissionHandler *handler.PermissionHandler,
+	rolePermissionHandler *handler.RolePermissionHandler,
+	userHandler *handler.UserHandler,
+	userRoleHandler *handler.UserRoleHandler,
+	authHandler *handler.AuthHandler,
+	auditHandler *handler.AuditHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	permissionMiddleware *middleware.PermissionMiddleware,
+) {
+	api_routing.RegisterAuthRoutes(app, authHandler, authMiddleware)
+	api_routing.RegisterRoleRoutes(app, roleHandler, rolePermissionHandler, authMiddleware, permissionMiddleware)
+	api_routing.RegisterPermissionRoutes(app, permissionHandler, rolePermissionHandler, authMiddleware, permissionMiddleware)
+	api_routing.RegisterRolePermissionRoutes(app, rolePermissionHandler, authMiddleware, permissionMiddleware)
+	api_routing.RegisterUserRoutes(app, userHandler, userRoleHandler, authMiddleware, permissionMiddleware)
+	api_routing.RegisterAuditRoutes(app, auditHandler, authMiddleware, permissionMiddleware)
 }
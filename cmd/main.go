@@ -1,20 +1,26 @@
 package main
 
 import (
-	"clean_architecture_fiber/cmd/seed"
 	"clean_architecture_fiber/config"
 	"clean_architecture_fiber/core/dependecy_injection"
 	i18nPkg "clean_architecture_fiber/pkg/i18n"
-	"context"
+	"flag"
 	"log"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/fx"
 )
 
 // main является точкой входа в приложение
-// Инициализирует конфигурацию, подключение к БД, i18n, запускает сидеры и Fiber сервер
+// Инициализирует конфигурацию, подключение к БД, i18n и запускает Fiber сервер
+// Миграции и сидеры запускаются при старте только если включены database.autoMigrate и database.autoSeed
 func main() {
+	// Флаги командной строки - последний слой конфигурации
+	loadOptions := config.LoadOptions{Overrides: config.Overrides{}}
+	flag.StringVar(&loadOptions.Dir, "config-dir", config.DefaultDir, "directory with env.yaml and env.<APP_ENV>.yaml")
+	flag.StringVar(&loadOptions.Env, "env", "", "environment name (default: $APP_ENV)")
+	flag.Var(loadOptions.Overrides, "set", "config override key=value, e.g. -set app.port=9090 (repeatable)")
+	flag.Parse()
+
 	// Инициализируем систему локализации (i18n)
 	log.Println("🌍 Initializing i18n...")
 	if err := i18nPkg.Init(); err != nil {
@@ -24,23 +30,14 @@ func main() {
 
 	// Создаем Fx приложение с DI контейнером
 	app := fx.New(
-		// Предоставляем конфигурацию
-		fx.Provide(func() *config.Config {
+		// Предоставляем конфигурацию и параметры ее загрузки (нужны для перезагрузки на лету)
+		fx.Supply(loadOptions),
+		fx.Provide(func(opts config.LoadOptions) (*config.Config, error) {
 			log.Println("📋 Loading application configuration...")
-			return config.LoadAppConfig()
+			return config.Load(opts)
 		}),
 		// Подключаем основной модуль приложения
 		dependecy_injection.AppModule,
-		// Запускаем сидеры после инициализации БД
-		fx.Invoke(func(lc fx.Lifecycle, pool *pgxpool.Pool) {
-			lc.Append(fx.Hook{
-				OnStart: func(ctx context.Context) error {
-					log.Println("🌱 Running database seeders...")
-					seed.RunSeeders(ctx, pool)
-					return nil
-				},
-			})
-		}),
 	)
 
 	// Запускаем приложение и ждем сигнала завершения
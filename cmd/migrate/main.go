@@ -0,0 +1,206 @@
+package main
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/db"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// migrationNameRegexp ограничивает имя новой миграции
+var migrationNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+// usage - справка по командам, флаги выводятся после нее
+const usage = `Usage: migrate [flags] <command> [args]
+
+Commands:
+  up           apply all pending migrations
+  down [N]     roll back the last N migrations (default 1)
+  goto V       migrate up or down to version V (0 - roll back all)
+  version      print the current version and dirty flag
+  force V      set version V without running migrations and clear the dirty flag
+  create NAME  create up/down files of a new migration in -dir
+
+Flags:
+`
+
+// command - разобранная команда CLI
+type command struct {
+	name      string
+	steps     int
+	version   uint
+	migration string
+}
+
+// main - CLI для встроенных миграций data/db/schema: migrate [флаги] <команда> [аргументы]
+//
+// Команды:
+//
+//	up           применить все новые миграции
+//	down [N]     откатить N последних миграций (по умолчанию 1)
+//	goto V       перейти к версии V (0 - откатить все)
+//	version      показать текущую версию
+//	force V      записать версию V без выполнения миграций
+//	create NAME  создать пару файлов новой миграции в -dir
+//
+// Строка подключения берется из -database-url, затем из DATABASE_URL, затем из конфигурации (config.Load, -set key=value)
+func main() {
+	databaseURL := flag.String("database-url", "", "PostgreSQL connection string (default: $DATABASE_URL or config)")
+	dir := flag.String("dir", "data/db/schema", "migrations directory (create)")
+	overrides := config.Overrides{}
+	flag.Var(overrides, "set", "config override key=value (repeatable)")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	cmd, err := parseCommand(flag.Args())
+	if err != nil {
+		fmt.Fprintf(flag.CommandLine.Output(), "❌ %v\n\n", err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if cmd.name == "create" {
+		if err := createMigration(*dir, cmd.migration); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dsn, err := resolveDatabaseURL(*databaseURL, overrides)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	migrator, err := db.NewMigrator(pool)
+	if err != nil {
+		log.Fatalf("❌ Failed to load migrations: %v", err)
+	}
+
+	switch cmd.name {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		err = migrator.Down(ctx, cmd.steps)
+	case "goto":
+		err = migrator.Goto(ctx, cmd.version)
+	case "force":
+		err = migrator.Force(ctx, cmd.version)
+	case "version":
+		var current uint
+		var dirty bool
+		current, dirty, err = migrator.Version(ctx)
+		if err == nil {
+			fmt.Printf("version: %d, dirty: %t\n", current, dirty)
+		}
+	}
+	if err != nil {
+		pool.Close()
+		log.Fatalf("❌ %v", err)
+	}
+}
+
+// parseCommand разбирает команду и ее аргументы до подключения к БД
+func parseCommand(args []string) (command, error) {
+	if len(args) == 0 {
+		return command{}, fmt.Errorf("command is required")
+	}
+	cmd := command{name: args[0], steps: 1}
+	args = args[1:]
+
+	switch cmd.name {
+	case "up", "version":
+		if len(args) > 0 {
+			return command{}, fmt.Errorf("%s takes no arguments", cmd.name)
+		}
+	case "down":
+		if len(args) > 1 {
+			return command{}, fmt.Errorf("down takes at most one argument: number of steps")
+		}
+		if len(args) == 1 {
+			steps, err := strconv.Atoi(args[0])
+			if err != nil || steps <= 0 {
+				return command{}, fmt.Errorf("invalid number of steps %q, expected a positive integer", args[0])
+			}
+			cmd.steps = steps
+		}
+	case "goto", "force":
+		if len(args) != 1 {
+			return command{}, fmt.Errorf("%s requires one argument: version", cmd.name)
+		}
+		version, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return command{}, fmt.Errorf("invalid version %q, expected a non-negative integer", args[0])
+		}
+		cmd.version = uint(version)
+	case "create":
+		if len(args) != 1 {
+			return command{}, fmt.Errorf("create requires one argument: migration name")
+		}
+		cmd.migration = args[0]
+	default:
+		return command{}, fmt.Errorf("unknown command %q", cmd.name)
+	}
+	return cmd, nil
+}
+
+// resolveDatabaseURL выбирает строку подключения: флаг, DATABASE_URL, конфигурация приложения
+func resolveDatabaseURL(flagValue string, overrides config.Overrides) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if env := os.Getenv("DATABASE_URL"); env != "" {
+		return env, nil
+	}
+	cfg, err := config.Load(config.LoadOptions{Overrides: overrides})
+	if err != nil {
+		return "", err
+	}
+	return cfg.GetDatabaseURL(), nil
+}
+
+// createMigration создает файлы {version}_{name}.up.sql и .down.sql со следующим номером версии
+func createMigration(dir, name string) error {
+	if !migrationNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid migration name %q: use letters, digits, '_' and '-'", name)
+	}
+
+	migrations, err := db.LoadMigrations(os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+	var next uint = 1
+	if len(migrations) > 0 {
+		next = migrations[len(migrations)-1].Version + 1
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", next, name, direction))
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			return err
+		}
+		log.Printf("✅ Created %s", path)
+	}
+	return nil
+}
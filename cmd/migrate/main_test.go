@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    command
+		wantErr string
+	}{
+		{args: []string{"up"}, want: command{name: "up", steps: 1}},
+		{args: []string{"version"}, want: command{name: "version", steps: 1}},
+		{args: []string{"down"}, want: command{name: "down", steps: 1}},
+		{args: []string{"down", "3"}, want: command{name: "down", steps: 3}},
+		{args: []string{"goto", "0"}, want: command{name: "goto", steps: 1, version: 0}},
+		{args: []string{"force", "7"}, want: command{name: "force", steps: 1, version: 7}},
+		{args: []string{"create", "add_users"}, want: command{name: "create", steps: 1, migration: "add_users"}},
+		{args: nil, wantErr: "command is required"},
+		{args: []string{"sideways"}, wantErr: `unknown command "sideways"`},
+		{args: []string{"up", "1"}, wantErr: "up takes no arguments"},
+		{args: []string{"down", "0"}, wantErr: "invalid number of steps"},
+		{args: []string{"down", "1", "2"}, wantErr: "at most one argument"},
+		{args: []string{"goto"}, wantErr: "goto requires one argument"},
+		{args: []string{"force", "-1"}, wantErr: "invalid version"},
+		{args: []string{"create"}, wantErr: "create requires one argument"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			got, err := parseCommand(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseCommand(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCommand(%q) error = %v", tt.args, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseCommand(%q) = %+v, want %+v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/seeders"
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// main - CLI сидирования ролей, разрешений и их связей из data/seeders/fixtures
+//
+// Флаги:
+//
+//	--dry-run                 показать запланированные изменения без записи в БД
+//	--only role,permission    ограничить цели (role, permission, role_permission)
+//	--env dev                 набор фикстур (по умолчанию app.env из конфигурации)
+//	--force                   разрешить запуск, если app.env конфигурации - production (--env на это не влияет)
+//	--database-url URL        строка подключения (по умолчанию $DATABASE_URL или конфигурация)
+//	--admin-email EMAIL       создать администратора с ролью admin (по умолчанию auth.admin.email)
+//	--admin-password PASS     пароль нового администратора (по умолчанию $ADMIN_PASSWORD или auth.admin.password)
+//	--set key=value           переопределить ключ конфигурации (можно повторять)
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print planned changes and roll back")
+	only := flag.String("only", "", "comma-separated targets: role, permission, role_permission")
+	env := flag.String("env", "", "fixture set (default: app.env from config); does not affect the production check")
+	force := flag.Bool("force", false, "allow seeding when app.env from config is production")
+	databaseURL := flag.String("database-url", "", "PostgreSQL connection string (default: $DATABASE_URL or config)")
+	adminEmail := flag.String("admin-email", "", "create this user with the admin role if missing (default: auth.admin.email)")
+	adminPassword := flag.String("admin-password", "", "password of a new admin user (default: $ADMIN_PASSWORD or auth.admin.password)")
+	overrides := config.Overrides{}
+	flag.Var(overrides, "set", "config override key=value (repeatable)")
+	flag.Parse()
+
+	targets, err := seeders.ParseTargets(*only)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	if *databaseURL == "" {
+		*databaseURL = os.Getenv("DATABASE_URL")
+	}
+	if *adminPassword == "" {
+		*adminPassword = os.Getenv("ADMIN_PASSWORD")
+	}
+	// Конфигурация нужна всегда: проверка production идет по app.env сидируемой базы, а не по --env
+	cfg, err := config.Load(config.LoadOptions{Overrides: overrides})
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *databaseURL == "" {
+		*databaseURL = cfg.GetDatabaseURL()
+	}
+	if *adminEmail == "" {
+		*adminEmail = cfg.Auth.Admin.Email
+		if *adminPassword == "" {
+			*adminPassword = cfg.Auth.Admin.Password
+		}
+	}
+	fixtureSet := *env
+	if fixtureSet == "" {
+		fixtureSet = cfg.App.Env
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, *databaseURL)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	report, err := seeders.Run(ctx, pool, seeders.RunOptions{
+		SeedOptions: seeders.SeedOptions{
+			DryRun: *dryRun,
+			Only:   targets,
+			Admin:  seeders.AdminOptions{Email: *adminEmail, Password: *adminPassword},
+		},
+		Env:        cfg.App.Env,
+		FixtureSet: fixtureSet,
+		Force:      *force,
+	})
+	if err != nil {
+		pool.Close()
+		log.Fatalf("❌ %v", err)
+	}
+
+	if *dryRun {
+		log.Printf("📝 Dry run (env %q, fixtures %q), nothing was written: %s", cfg.App.Env, fixtureSet, report)
+		return
+	}
+	log.Printf("🌱 Seeding finished (env %q, fixtures %q): %s", cfg.App.Env, fixtureSet, report)
+}
@@ -2,11 +2,26 @@ package main
 
 import (
 	"clean_architecture_fiber/config"
+	"flag"
 	"fmt"
+	"os"
 )
 
+// main печатает DSN базы данных из конфигурации
+// Пароль маскируется, если не указан -reveal
 func main() {
-	cfg := config.LoadAppConfig()
-	dsn := cfg.GetDatabaseURL()
+	reveal := flag.Bool("reveal", false, "print the DSN with the real password")
+	flag.Parse()
+
+	cfg, err := config.LoadAppConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	dsn := cfg.RedactedDatabaseURL()
+	if *reveal {
+		dsn = cfg.GetDatabaseURL()
+	}
 	fmt.Print(dsn)
 }
@@ -1,10 +1,9 @@
 package config
 
 import (
-	"fmt"
-	"github.com/spf13/viper"
-	"log"
-	"os"
+	"net"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -18,12 +17,70 @@ type AppConfig struct {
 }
 
 type DatabaseConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     int    `mapstructure:"port"`
-	User     string `mapstructure:"user"`
-	Password string `mapstructure:"password"`
-	Name     string `mapstructure:"name"`
-	SSL      bool   `mapstructure:"ssl"`
+	Host             string             `mapstructure:"host"`
+	Port             int                `mapstructure:"port"`
+	User             string             `mapstructure:"user"`
+	Password         string             `mapstructure:"password" secret:"true"`
+	Name             string             `mapstructure:"name"`
+	SSL              bool               `mapstructure:"ssl"`
+	SSLMode          string             `mapstructure:"sslMode"`
+	SSLRootCert      string             `mapstructure:"sslRootCert"`
+	SSLCert          string             `mapstructure:"sslCert"`
+	SSLKey           string             `mapstructure:"sslKey"`
+	ConnectTimeout   time.Duration      `mapstructure:"connectTimeout"`
+	StatementTimeout time.Duration      `mapstructure:"statementTimeout"`
+	ApplicationName  string             `mapstructure:"applicationName"`
+	SearchPath       string             `mapstructure:"searchPath"`
+	TimeZone         string             `mapstructure:"timeZone"`
+	Pool             DatabasePoolConfig `mapstructure:"pool"`
+	Replica          ReplicaConfig      `mapstructure:"replica"`
+	Transaction      TransactionConfig  `mapstructure:"transaction"`
+	AutoMigrate      bool               `mapstructure:"autoMigrate"`
+	AutoSeed         bool               `mapstructure:"autoSeed"`
+}
+
+// ReplicaConfig - реплика только для чтения, пустые port, user и password берутся из основной БД
+type ReplicaConfig struct {
+	Enabled             bool          `mapstructure:"enabled"`
+	Host                string        `mapstructure:"host"`
+	Port                int           `mapstructure:"port"`
+	User                string        `mapstructure:"user"`
+	Password            string        `mapstructure:"password" secret:"true"`
+	HealthCheckInterval time.Duration `mapstructure:"healthCheckInterval"`
+	MaxLag              time.Duration `mapstructure:"maxLag"`
+}
+
+// DatabasePoolConfig - параметры пула pgxpool, нулевые значения оставляют значения pgxpool по умолчанию
+type DatabasePoolConfig struct {
+	MaxConns              int32         `mapstructure:"maxConns"`
+	MinConns              int32         `mapstructure:"minConns"`
+	MaxConnLifetime       time.Duration `mapstructure:"maxConnLifetime"`
+	MaxConnLifetimeJitter time.Duration `mapstructure:"maxConnLifetimeJitter"`
+	MaxConnIdleTime       time.Duration `mapstructure:"maxConnIdleTime"`
+	HealthCheckPeriod     time.Duration `mapstructure:"healthCheckPeriod"`
+}
+
+// TransactionConfig - параметры транзакций TxManager по умолчанию
+type TransactionConfig struct {
+	IsolationLevel string `mapstructure:"isolationLevel"`
+	MaxRetries     int    `mapstructure:"maxRetries"`
+}
+
+// SSLModes - допустимые значения database.sslMode (как в libpq)
+var SSLModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// IsolationLevels - допустимые значения database.transaction.isolationLevel
+var IsolationLevels = []string{"read_committed", "repeatable_read", "serializable"}
+
+// EffectiveSSLMode возвращает sslMode, а если он не задан - require или disable по флагу ssl
+func (db DatabaseConfig) EffectiveSSLMode() string {
+	if db.SSLMode != "" {
+		return db.SSLMode
+	}
+	if db.SSL {
+		return "require"
+	}
+	return "disable"
 }
 
 type FiberConfig struct {
@@ -43,45 +100,130 @@ type FiberConfig struct {
 	DisableStartupMessage bool          `mapstructure:"disableStartupMessage"`
 }
 
-type Config struct {
-	App      AppConfig      `mapstructure:"app"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Fiber    FiberConfig    `mapstructure:"fiber"`
+type PaginationConfig struct {
+	CursorSecret string `mapstructure:"cursorSecret" secret:"true"`
 }
 
-func LoadAppConfig() *Config {
-	env := os.Getenv("APP_ENV")
-	fileName := fmt.Sprintf("env.yaml", env)
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath("./config")
-	viper.SetConfigFile("./config/env.yaml")
+type AuthConfig struct {
+	Issuer             string        `mapstructure:"issuer"`
+	AccessTokenSecret  string        `mapstructure:"accessTokenSecret" secret:"true"`
+	RefreshTokenSecret string        `mapstructure:"refreshTokenSecret" secret:"true"`
+	AccessTokenTTL     time.Duration `mapstructure:"accessTokenTTL"`
+	RefreshTokenTTL    time.Duration `mapstructure:"refreshTokenTTL"`
+	Admin              AdminConfig   `mapstructure:"admin"`
+}
 
-	// Читаем конфигурацию
-	if err := viper.ReadInConfig(); err != nil {
-		log.Fatalf("❌ Не удалось загрузить конфигурацию (%s): %v", fileName, err)
-	}
+// AdminConfig - первый администратор, которого создает сидер с ролью admin (пустой email - не создавать)
+type AdminConfig struct {
+	Email    string `mapstructure:"email"`
+	Password string `mapstructure:"password" secret:"true"`
+}
 
-	var cfg Config
-	if err := viper.Unmarshal(&cfg); err != nil {
-		log.Fatalf("❌ Ошибка при парсинге YAML: %v", err)
-	}
+// Поля с тегом reload:"true" применяются на лету при изменении файлов конфигурации (см. Watcher)
+
+type LogConfig struct {
+	Level string `mapstructure:"level" reload:"true"`
+}
+
+type CorsConfig struct {
+	AllowOrigins []string `mapstructure:"allowOrigins" reload:"true"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool          `mapstructure:"enabled" reload:"true"`
+	Max     int           `mapstructure:"max" reload:"true"`
+	Window  time.Duration `mapstructure:"window" reload:"true"`
+}
+
+type I18nConfig struct {
+	DefaultLanguage string `mapstructure:"defaultLanguage" reload:"true"`
+}
 
-	log.Printf("✅ Загружена конфигурация: %s (%s)\n", cfg.App.Name, cfg.App.Env)
-	return &cfg
+// TrashConfig - очистка корзины: мягко удаленные роли и разрешения старше retention удаляются безвозвратно
+type TrashConfig struct {
+	PurgeEnabled  bool          `mapstructure:"purgeEnabled" reload:"true"`
+	Retention     time.Duration `mapstructure:"retention" reload:"true"`
+	PurgeInterval time.Duration `mapstructure:"purgeInterval" reload:"true"`
+	BatchSize     int32         `mapstructure:"batchSize" reload:"true"`
+}
+
+type Config struct {
+	App        AppConfig        `mapstructure:"app"`
+	Database   DatabaseConfig   `mapstructure:"database"`
+	Fiber      FiberConfig      `mapstructure:"fiber"`
+	Pagination PaginationConfig `mapstructure:"pagination"`
+	Auth       AuthConfig       `mapstructure:"auth"`
+	Log        LogConfig        `mapstructure:"log"`
+	Cors       CorsConfig       `mapstructure:"cors"`
+	RateLimit  RateLimitConfig  `mapstructure:"rateLimit"`
+	I18n       I18nConfig       `mapstructure:"i18n"`
+	Trash      TrashConfig      `mapstructure:"trash"`
 }
 
+// GetDatabaseURL возвращает DSN подключения к PostgreSQL, включая пароль
+// Для логов используйте RedactedDatabaseURL
 func (cfg *Config) GetDatabaseURL() string {
-	sslMode := "disable"
-	if cfg.Database.SSL {
-		sslMode = "require"
+	return cfg.Database.URL()
+}
+
+// RedactedDatabaseURL возвращает DSN с замаскированным паролем
+func (cfg *Config) RedactedDatabaseURL() string {
+	return cfg.Database.RedactedURL()
+}
+
+// URL возвращает DSN подключения, включая пароль
+func (db DatabaseConfig) URL() string {
+	return db.url().String()
+}
+
+// RedactedURL возвращает DSN с замаскированным паролем
+func (db DatabaseConfig) RedactedURL() string {
+	return db.url().Redacted()
+}
+
+// ReplicaDatabase возвращает настройки подключения к реплике
+// Имя БД, TLS, таймауты, параметры сессии и пула совпадают с основной БД
+func (db DatabaseConfig) ReplicaDatabase() DatabaseConfig {
+	replica := db
+	replica.Host = db.Replica.Host
+	if db.Replica.Port != 0 {
+		replica.Port = db.Replica.Port
+	}
+	if db.Replica.User != "" {
+		replica.User = db.Replica.User
+	}
+	if db.Replica.Password != "" {
+		replica.Password = db.Replica.Password
+	}
+	replica.Replica = ReplicaConfig{}
+	replica.Transaction = TransactionConfig{}
+	return replica
+}
+
+// url собирает DSN, экранируя пользователя и пароль
+// Параметры libpq (TLS, application_name, connect_timeout) попадают в DSN, поэтому их учитывают и CLI
+// Параметры пула и сессии применяются в NewPgPool
+func (db DatabaseConfig) url() *url.URL {
+	query := url.Values{"sslmode": {db.EffectiveSSLMode()}}
+	setIfNotEmpty := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	setIfNotEmpty("sslrootcert", db.SSLRootCert)
+	setIfNotEmpty("sslcert", db.SSLCert)
+	setIfNotEmpty("sslkey", db.SSLKey)
+	setIfNotEmpty("application_name", db.ApplicationName)
+	if db.ConnectTimeout > 0 {
+		// libpq принимает connect_timeout в целых секундах
+		query.Set("connect_timeout", strconv.Itoa(int(max(db.ConnectTimeout/time.Second, 1))))
+	}
+
+	return &url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(db.User, db.Password),
+		Host:     net.JoinHostPort(db.Host, strconv.Itoa(db.Port)),
+		Path:     "/" + db.Name,
+		RawQuery: query.Encode(),
 	}
-	return fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
-		cfg.Database.User,
-		cfg.Database.Password,
-		cfg.Database.Host,
-		cfg.Database.Port,
-		cfg.Database.Name,
-		sslMode,
-	)
 }
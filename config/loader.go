@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultDir - каталог с файлами конфигурации по умолчанию
+	DefaultDir = "./config"
+	// BaseFileName - базовый файл конфигурации, общий для всех окружений
+	BaseFileName = "env.yaml"
+	// EnvVariable - переменная окружения с именем окружения (dev, production, ...)
+	EnvVariable = "APP_ENV"
+	// EnvPrefix - префикс переменных окружения, переопределяющих ключи (APP_DATABASE_PASSWORD -> database.password)
+	EnvPrefix = "APP"
+)
+
+// Overrides - значения ключей из флагов командной строки (-set database.port=5433)
+// Реализует flag.Value, поэтому флаг можно указывать несколько раз
+type Overrides map[string]string
+
+// String возвращает переопределения в виде key=value через запятую
+func (o Overrides) String() string {
+	pairs := make([]string, 0, len(o))
+	for key, value := range o {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set разбирает одно переопределение вида key=value
+func (o Overrides) Set(pair string) error {
+	key, value, ok := strings.Cut(pair, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("invalid config override %q, expected key=value", pair)
+	}
+	o[key] = value
+	return nil
+}
+
+// LoadOptions - параметры загрузки конфигурации
+type LoadOptions struct {
+	// Dir - каталог с файлами конфигурации (по умолчанию DefaultDir)
+	Dir string
+	// Env - окружение, по умолчанию берется из APP_ENV
+	Env string
+	// Overrides - значения из флагов командной строки, имеют наивысший приоритет
+	Overrides Overrides
+}
+
+// Load загружает конфигурацию слоями, каждый следующий слой переопределяет предыдущий:
+//  1. базовый файл env.yaml
+//  2. файл окружения env.<APP_ENV>.yaml (необязательный)
+//  3. переменные окружения APP_<SECTION>_<KEY> (например APP_DATABASE_PASSWORD)
+//  4. переопределения из флагов командной строки
+//
+// Результат проверяется через Validate
+func Load(opts LoadOptions) (*Config, error) {
+	dir := opts.Dir
+	if dir == "" {
+		dir = DefaultDir
+	}
+	env := opts.Env
+	if env == "" {
+		env = os.Getenv(EnvVariable)
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	// Слой 1: базовый файл
+	baseFile := filepath.Join(dir, BaseFileName)
+	v.SetConfigFile(baseFile)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", baseFile, err)
+	}
+	files := []string{baseFile}
+
+	// Слой 2: файл окружения
+	if env != "" {
+		envFile := filepath.Join(dir, fmt.Sprintf("env.%s.yaml", env))
+		if _, err := os.Stat(envFile); err == nil {
+			v.SetConfigFile(envFile)
+			if err := v.MergeInConfig(); err != nil {
+				return nil, fmt.Errorf("failed to read config %s: %w", envFile, err)
+			}
+			files = append(files, envFile)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read config %s: %w", envFile, err)
+		}
+		v.Set("app.env", env)
+	}
+
+	// Слой 3: переменные окружения
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	bindEnvs(v, reflect.TypeOf(Config{}), "")
+
+	// Слой 4: флаги командной строки
+	for key, value := range opts.Overrides {
+		v.Set(key, value)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Загружена конфигурация: %s (%s), файлы: %s", cfg.App.Name, cfg.App.Env, strings.Join(files, ", "))
+	return &cfg, nil
+}
+
+// LoadAppConfig загружает конфигурацию из ./config с окружением из APP_ENV
+func LoadAppConfig() (*Config, error) {
+	return Load(LoadOptions{})
+}
+
+// bindEnvs регистрирует переменную окружения для каждого ключа структуры конфигурации
+// Без явной привязки viper не видит переменные для ключей, отсутствующих в файлах
+func bindEnvs(v *viper.Viper, t reflect.Type, prefix string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("mapstructure")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		key := tag
+		if prefix != "" {
+			key = prefix + "." + tag
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			bindEnvs(v, field.Type, key)
+			continue
+		}
+		_ = v.BindEnv(key)
+	}
+}
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeConfigDir создает каталог конфигурации: базовый файл из env.example.yaml и необязательный env.staging.yaml
+func writeConfigDir(t *testing.T, envFile string) string {
+	t.Helper()
+	base, err := os.ReadFile("env.example.yaml")
+	if err != nil {
+		t.Fatalf("read env.example.yaml: %v", err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, BaseFileName), base, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if envFile != "" {
+		if err := os.WriteFile(filepath.Join(dir, "env.staging.yaml"), []byte(envFile), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadLayerPrecedence(t *testing.T) {
+	const envFile = "database:\n  port: 6001\n  name: staging_db\n"
+
+	tests := []struct {
+		name      string
+		envFile   string
+		env       string
+		envVars   map[string]string
+		overrides Overrides
+		wantPort  int
+		wantName  string
+		wantEnv   string
+	}{
+		{
+			name:     "base file only",
+			wantPort: 5432,
+			wantName: "go_clean_project",
+			wantEnv:  "dev",
+		},
+		{
+			name:     "env file overrides base",
+			envFile:  envFile,
+			env:      "staging",
+			wantPort: 6001,
+			wantName: "staging_db",
+			wantEnv:  "staging",
+		},
+		{
+			name:     "missing env file is skipped",
+			env:      "staging",
+			wantPort: 5432,
+			wantName: "go_clean_project",
+			wantEnv:  "staging",
+		},
+		{
+			name:     "env from APP_ENV",
+			envFile:  envFile,
+			envVars:  map[string]string{EnvVariable: "staging"},
+			wantPort: 6001,
+			wantName: "staging_db",
+			wantEnv:  "staging",
+		},
+		{
+			name:     "variable overrides env file",
+			envFile:  envFile,
+			env:      "staging",
+			envVars:  map[string]string{"APP_DATABASE_PORT": "6002"},
+			wantPort: 6002,
+			wantName: "staging_db",
+			wantEnv:  "staging",
+		},
+		{
+			name:      "flag overrides variable",
+			envFile:   envFile,
+			env:       "staging",
+			envVars:   map[string]string{"APP_DATABASE_PORT": "6002", "APP_DATABASE_NAME": "from_env"},
+			overrides: Overrides{"database.port": "6003"},
+			wantPort:  6003,
+			wantName:  "from_env",
+			wantEnv:   "staging",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvVariable, "")
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := Load(LoadOptions{Dir: writeConfigDir(t, tt.envFile), Env: tt.env, Overrides: tt.overrides})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if cfg.Database.Port != tt.wantPort || cfg.Database.Name != tt.wantName || cfg.App.Env != tt.wantEnv {
+				t.Errorf("Load() database.port = %d, database.name = %q, app.env = %q, want %d, %q, %q",
+					cfg.Database.Port, cfg.Database.Name, cfg.App.Env, tt.wantPort, tt.wantName, tt.wantEnv)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		dir       func(t *testing.T) string
+		env       string
+		overrides Overrides
+	}{
+		{
+			name: "missing base file",
+			dir:  func(t *testing.T) string { return t.TempDir() },
+		},
+		{
+			name: "malformed env file",
+			dir:  func(t *testing.T) string { return writeConfigDir(t, "database: [\n") },
+			env:  "staging",
+		},
+		{
+			name:      "non-numeric override",
+			dir:       func(t *testing.T) string { return writeConfigDir(t, "") },
+			overrides: Overrides{"database.port": "abc"},
+		},
+		{
+			name:      "invalid value after layering",
+			dir:       func(t *testing.T) string { return writeConfigDir(t, "") },
+			overrides: Overrides{"database.port": "70000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvVariable, "")
+			if _, err := Load(LoadOptions{Dir: tt.dir(t), Env: tt.env, Overrides: tt.overrides}); err == nil {
+				t.Errorf("Load() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestOverridesSet(t *testing.T) {
+	tests := []struct {
+		pair    string
+		wantKey string
+		wantVal string
+		wantErr bool
+	}{
+		{pair: "database.port=5433", wantKey: "database.port", wantVal: "5433"},
+		{pair: " log.level =debug", wantKey: "log.level", wantVal: "debug"},
+		{pair: "auth.issuer=a=b", wantKey: "auth.issuer", wantVal: "a=b"},
+		{pair: "database.name=", wantKey: "database.name", wantVal: ""},
+		{pair: "no-separator", wantErr: true},
+		{pair: "=value", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pair, func(t *testing.T) {
+			overrides := Overrides{}
+			err := overrides.Set(tt.pair)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, want error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(overrides) != 1 || overrides[tt.wantKey] != tt.wantVal) {
+				t.Errorf("Set() = %v, want %s=%s", overrides, tt.wantKey, tt.wantVal)
+			}
+		})
+	}
+}
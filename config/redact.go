@@ -0,0 +1,44 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// RedactedValue заменяет непустые секреты в Redacted()
+const RedactedValue = "******"
+
+// Redacted возвращает копию конфигурации, в которой поля с тегом secret:"true" замаскированы
+// Используйте ее для любого вывода конфигурации в логи
+func (cfg Config) Redacted() Config {
+	redactSecrets(reflect.ValueOf(&cfg).Elem())
+	return cfg
+}
+
+// String выводит замаскированную конфигурацию, поэтому Config безопасно передавать в log.Printf
+func (cfg Config) String() string {
+	data, err := json.Marshal(cfg.Redacted())
+	if err != nil {
+		return "<config: " + err.Error() + ">"
+	}
+	return string(data)
+}
+
+// GoString маскирует секреты и для формата %#v
+func (cfg Config) GoString() string {
+	return cfg.String()
+}
+
+// redactSecrets рекурсивно маскирует строковые поля с тегом secret:"true"
+func redactSecrets(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		switch {
+		case field.Kind() == reflect.Struct:
+			redactSecrets(field)
+		case field.Kind() == reflect.String && t.Field(i).Tag.Get("secret") == "true" && field.String() != "":
+			field.SetString(RedactedValue)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// setSecrets записывает в каждое поле с тегом secret:"true" уникальное значение и возвращает эти значения
+func setSecrets(t *testing.T, v reflect.Value, path string) []string {
+	t.Helper()
+	var secrets []string
+	for i := 0; i < v.NumField(); i++ {
+		field, structField := v.Field(i), v.Type().Field(i)
+		switch {
+		case field.Kind() == reflect.Struct:
+			secrets = append(secrets, setSecrets(t, field, path+structField.Name+".")...)
+		case structField.Tag.Get("secret") == "true":
+			secret := "s3cr3t-" + path + structField.Name
+			field.SetString(secret)
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := loadExample(t)
+	secrets := setSecrets(t, reflect.ValueOf(cfg).Elem(), "")
+	if len(secrets) < 4 {
+		t.Fatalf("found %d secret fields, want at least the database password, token secrets and cursor secret", len(secrets))
+	}
+
+	redacted := cfg.Redacted()
+	if redacted.Database.Password != RedactedValue || redacted.Auth.AccessTokenSecret != RedactedValue {
+		t.Errorf("Redacted() database.password = %q, auth.accessTokenSecret = %q, want %q",
+			redacted.Database.Password, redacted.Auth.AccessTokenSecret, RedactedValue)
+	}
+	if redacted.Database.Host != cfg.Database.Host || redacted.App.Name != cfg.App.Name {
+		t.Errorf("Redacted() changed fields without secret tag")
+	}
+	if !strings.HasPrefix(cfg.Database.Password, "s3cr3t-") {
+		t.Errorf("Redacted() modified the original config")
+	}
+
+	outputs := map[string]string{
+		"%v":                    fmt.Sprintf("%v", *cfg),
+		"%+v":                   fmt.Sprintf("%+v", *cfg),
+		"%#v":                   fmt.Sprintf("%#v", *cfg),
+		"%v pointer":            fmt.Sprintf("%v", cfg),
+		"%s":                    fmt.Sprintf("%s", cfg),
+		"RedactedDatabaseURL()": cfg.RedactedDatabaseURL(),
+	}
+	for name, output := range outputs {
+		for _, secret := range secrets {
+			if strings.Contains(output, secret) {
+				t.Errorf("%s contains secret %s: %s", name, secret, output)
+			}
+		}
+	}
+
+	if !strings.Contains(cfg.GetDatabaseURL(), cfg.Database.Password) {
+		t.Errorf("GetDatabaseURL() must keep the password for connecting")
+	}
+}
+
+func TestRedactedKeepsEmptySecrets(t *testing.T) {
+	cfg := loadExample(t)
+	cfg.Database.Password = ""
+	if got := cfg.Redacted().Database.Password; got != "" {
+		t.Errorf("Redacted() database.password = %q, want empty", got)
+	}
+}
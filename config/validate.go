@@ -0,0 +1,209 @@
+package config
+
+import (
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ProductionEnv - значение app.env production окружения, для него проверки строже
+const ProductionEnv = "production"
+
+// exampleSecrets - секреты из env.example.yaml, в production они равносильны публично известным
+var exampleSecrets = []string{"change-me", "change-me-access", "change-me-refresh"}
+
+// LogLevels - допустимые значения log.level
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+// ValidationError содержит все найденные проблемы конфигурации
+type ValidationError struct {
+	Problems []string
+}
+
+// Error возвращает список проблем, по одной на строку
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// configValidator накапливает проблемы конфигурации
+type configValidator struct {
+	problems []string
+}
+
+func (v *configValidator) addf(key, format string, args ...any) {
+	v.problems = append(v.problems, key+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *configValidator) required(key, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf(key, "is required")
+	}
+}
+
+// secret требует непустой секрет, отличный от значений из env.example.yaml
+func (v *configValidator) secret(key, value string) {
+	v.required(key, value)
+	if slices.Contains(exampleSecrets, strings.TrimSpace(value)) {
+		v.addf(key, "must not use the example value %q", value)
+	}
+}
+
+func (v *configValidator) port(key string, value int) {
+	if value < 1 || value > 65535 {
+		v.addf(key, "must be between 1 and 65535, got %d", value)
+	}
+}
+
+func (v *configValidator) nonNegative(key string, value time.Duration) {
+	if value < 0 {
+		v.addf(key, "must not be negative, got %s", value)
+	}
+}
+
+// database проверяет TLS, таймауты и параметры пула
+func (v *configValidator) database(db DatabaseConfig) {
+	sslMode := db.EffectiveSSLMode()
+	if !slices.Contains(SSLModes, sslMode) {
+		v.addf("database.sslMode", "must be one of %s, got %q", strings.Join(SSLModes, ", "), sslMode)
+	}
+	if (sslMode == "verify-ca" || sslMode == "verify-full") && db.SSLRootCert == "" {
+		v.addf("database.sslRootCert", "is required for sslMode %s", sslMode)
+	}
+	if (db.SSLCert == "") != (db.SSLKey == "") {
+		v.addf("database.sslCert", "database.sslCert and database.sslKey must be set together")
+	}
+	v.file("database.sslRootCert", db.SSLRootCert)
+	v.file("database.sslCert", db.SSLCert)
+	v.file("database.sslKey", db.SSLKey)
+
+	v.nonNegative("database.connectTimeout", db.ConnectTimeout)
+	v.nonNegative("database.statementTimeout", db.StatementTimeout)
+
+	if db.Pool.MaxConns < 0 {
+		v.addf("database.pool.maxConns", "must not be negative, got %d", db.Pool.MaxConns)
+	}
+	if db.Pool.MinConns < 0 {
+		v.addf("database.pool.minConns", "must not be negative, got %d", db.Pool.MinConns)
+	}
+	if db.Pool.MaxConns > 0 && db.Pool.MinConns > db.Pool.MaxConns {
+		v.addf("database.pool.minConns", "must not exceed database.pool.maxConns (%d > %d)", db.Pool.MinConns, db.Pool.MaxConns)
+	}
+	v.nonNegative("database.pool.maxConnLifetime", db.Pool.MaxConnLifetime)
+	v.nonNegative("database.pool.maxConnLifetimeJitter", db.Pool.MaxConnLifetimeJitter)
+	v.nonNegative("database.pool.maxConnIdleTime", db.Pool.MaxConnIdleTime)
+	v.nonNegative("database.pool.healthCheckPeriod", db.Pool.HealthCheckPeriod)
+
+	if level := db.Transaction.IsolationLevel; level != "" && !slices.Contains(IsolationLevels, level) {
+		v.addf("database.transaction.isolationLevel", "must be one of %s, got %q", strings.Join(IsolationLevels, ", "), level)
+	}
+	if db.Transaction.MaxRetries < 0 {
+		v.addf("database.transaction.maxRetries", "must not be negative, got %d", db.Transaction.MaxRetries)
+	}
+
+	if db.Replica.Enabled {
+		v.required("database.replica.host", db.Replica.Host)
+		if db.Replica.Port != 0 {
+			v.port("database.replica.port", db.Replica.Port)
+		}
+		v.nonNegative("database.replica.healthCheckInterval", db.Replica.HealthCheckInterval)
+		v.nonNegative("database.replica.maxLag", db.Replica.MaxLag)
+	}
+}
+
+func (v *configValidator) file(key, path string) {
+	if path == "" {
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		v.addf(key, "%v", err)
+	}
+}
+
+// IsProduction сообщает, запущено ли приложение в production окружении
+func (cfg *Config) IsProduction() bool {
+	return strings.EqualFold(cfg.App.Env, ProductionEnv)
+}
+
+// Validate проверяет обязательные поля, диапазоны портов и длительности
+// Возвращает *ValidationError со всеми найденными проблемами сразу
+func (cfg *Config) Validate() error {
+	v := &configValidator{}
+
+	// app
+	v.required("app.name", cfg.App.Name)
+	v.required("app.env", cfg.App.Env)
+	v.port("app.port", cfg.App.Port)
+
+	// database
+	v.required("database.host", cfg.Database.Host)
+	v.port("database.port", cfg.Database.Port)
+	v.required("database.user", cfg.Database.User)
+	v.required("database.name", cfg.Database.Name)
+	v.database(cfg.Database)
+
+	// fiber
+	if cfg.Fiber.Concurrency < 0 {
+		v.addf("fiber.concurrency", "must not be negative, got %d", cfg.Fiber.Concurrency)
+	}
+	v.nonNegative("fiber.readTimeout", cfg.Fiber.ReadTimeout)
+	v.nonNegative("fiber.writeTimeout", cfg.Fiber.WriteTimeout)
+	v.nonNegative("fiber.idleTimeout", cfg.Fiber.IdleTimeout)
+
+	// auth: нулевые TTL заменяются значениями по умолчанию
+	v.nonNegative("auth.accessTokenTTL", cfg.Auth.AccessTokenTTL)
+	v.nonNegative("auth.refreshTokenTTL", cfg.Auth.RefreshTokenTTL)
+	if cfg.Auth.AccessTokenTTL > 0 && cfg.Auth.RefreshTokenTTL > 0 && cfg.Auth.AccessTokenTTL >= cfg.Auth.RefreshTokenTTL {
+		v.addf("auth.accessTokenTTL", "must be shorter than auth.refreshTokenTTL (%s >= %s)", cfg.Auth.AccessTokenTTL, cfg.Auth.RefreshTokenTTL)
+	}
+	if cfg.Auth.AccessTokenSecret != "" && cfg.Auth.AccessTokenSecret == cfg.Auth.RefreshTokenSecret {
+		v.addf("auth.refreshTokenSecret", "must differ from auth.accessTokenSecret")
+	}
+	if cfg.Auth.Admin.Email != "" {
+		v.required("auth.admin.password", cfg.Auth.Admin.Password)
+	}
+
+	// log
+	if cfg.Log.Level != "" && !slices.Contains(LogLevels, strings.ToLower(cfg.Log.Level)) {
+		v.addf("log.level", "must be one of %s, got %q", strings.Join(LogLevels, ", "), cfg.Log.Level)
+	}
+
+	// rateLimit
+	if cfg.RateLimit.Enabled {
+		if cfg.RateLimit.Max < 1 {
+			v.addf("rateLimit.max", "must be positive when rate limiting is enabled, got %d", cfg.RateLimit.Max)
+		}
+		if cfg.RateLimit.Window <= 0 {
+			v.addf("rateLimit.window", "must be positive when rate limiting is enabled, got %s", cfg.RateLimit.Window)
+		}
+	}
+
+	// i18n
+	if cfg.I18n.DefaultLanguage != "" && !i18nPkg.IsLanguageSupported(cfg.I18n.DefaultLanguage) {
+		v.addf("i18n.defaultLanguage", "must be one of %s, got %q", strings.Join(i18nPkg.SupportedLanguages, ", "), cfg.I18n.DefaultLanguage)
+	}
+
+	// trash: нулевые purgeInterval и batchSize заменяются значениями по умолчанию
+	if cfg.Trash.PurgeEnabled && cfg.Trash.Retention <= 0 {
+		v.addf("trash.retention", "must be positive when trash purge is enabled, got %s", cfg.Trash.Retention)
+	}
+	v.nonNegative("trash.purgeInterval", cfg.Trash.PurgeInterval)
+	if cfg.Trash.BatchSize < 0 {
+		v.addf("trash.batchSize", "must not be negative, got %d", cfg.Trash.BatchSize)
+	}
+
+	// В production случайные секреты недопустимы: токены и курсоры не переживут перезапуск и не совпадут между инстансами
+	// Секреты из примера конфигурации известны всем, с ними можно подделать токены и курсоры
+	if cfg.IsProduction() {
+		v.secret("auth.accessTokenSecret", cfg.Auth.AccessTokenSecret)
+		v.secret("auth.refreshTokenSecret", cfg.Auth.RefreshTokenSecret)
+		v.secret("pagination.cursorSecret", cfg.Pagination.CursorSecret)
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// loadExample загружает env.example.yaml как единственный файл конфигурации
+func loadExample(t *testing.T) *Config {
+	t.Helper()
+	t.Setenv(EnvVariable, "")
+	cfg, err := Load(LoadOptions{Dir: writeConfigDir(t, "")})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(cfg *Config)
+		wantProblems []string
+	}{
+		{
+			name:   "example config",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			name: "production with secrets",
+			mutate: func(cfg *Config) {
+				cfg.App.Env = ProductionEnv
+				cfg.Auth.AccessTokenSecret = "prod-access-secret"
+				cfg.Auth.RefreshTokenSecret = "prod-refresh-secret"
+				cfg.Pagination.CursorSecret = "prod-cursor-secret"
+			},
+		},
+		{
+			name: "production without secrets",
+			mutate: func(cfg *Config) {
+				cfg.App.Env = "Production"
+				cfg.Auth.AccessTokenSecret = ""
+				cfg.Auth.RefreshTokenSecret = ""
+				cfg.Pagination.CursorSecret = " "
+			},
+			wantProblems: []string{
+				"auth.accessTokenSecret: is required",
+				"auth.refreshTokenSecret: is required",
+				"pagination.cursorSecret: is required",
+			},
+		},
+		{
+			name: "production with example secrets",
+			mutate: func(cfg *Config) {
+				cfg.App.Env = ProductionEnv
+				cfg.Auth.RefreshTokenSecret = "prod-refresh-secret"
+			},
+			wantProblems: []string{
+				`auth.accessTokenSecret: must not use the example value "change-me-access"`,
+				`pagination.cursorSecret: must not use the example value "change-me"`,
+			},
+		},
+		{
+			name: "empty secrets outside production",
+			mutate: func(cfg *Config) {
+				cfg.Auth.AccessTokenSecret = ""
+				cfg.Auth.RefreshTokenSecret = ""
+				cfg.Pagination.CursorSecret = ""
+			},
+		},
+		{
+			name: "all problems in one error",
+			mutate: func(cfg *Config) {
+				cfg.App.Port = 0
+				cfg.Database.Name = ""
+				cfg.Fiber.ReadTimeout = -time.Second
+			},
+			wantProblems: []string{
+				"app.port: must be between 1 and 65535, got 0",
+				"database.name: is required",
+				"fiber.readTimeout: must not be negative, got -1s",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadExample(t)
+			tt.mutate(cfg)
+
+			err := cfg.Validate()
+			if tt.wantProblems == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+			if !reflect.DeepEqual(validationErr.Problems, tt.wantProblems) {
+				t.Errorf("Validate() problems = %q, want %q", validationErr.Problems, tt.wantProblems)
+			}
+			for _, problem := range tt.wantProblems {
+				if !strings.Contains(err.Error(), "\n  - "+problem) {
+					t.Errorf("Validate() error = %q, want line %q", err.Error(), problem)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce - пауза после последнего события файловой системы перед перечитыванием
+// Редакторы часто пишут файл в несколько приемов (truncate + write, rename)
+const reloadDebounce = 300 * time.Millisecond
+
+// Watcher следит за файлами конфигурации и применяет изменения полей с тегом reload:"true"
+// Изменения остальных (структурных) полей, например app.port или database.host, отклоняются с предупреждением
+// Компоненты получают новую конфигурацию через Subscribe
+type Watcher struct {
+	opts LoadOptions
+
+	mu          sync.RWMutex
+	current     *Config
+	subscribers []func(cfg *Config)
+
+	fsWatcher *fsnotify.Watcher
+	done      chan struct{}
+}
+
+// NewWatcher создает Watcher для конфигурации cfg, загруженной с параметрами opts
+func NewWatcher(cfg *Config, opts LoadOptions) *Watcher {
+	return &Watcher{opts: opts, current: cfg}
+}
+
+// Current возвращает актуальную конфигурацию
+func (w *Watcher) Current() *Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.current
+}
+
+// Subscribe регистрирует обработчик, вызываемый после применения изменений
+// Обработчик получает полную конфигурацию, структурные поля в ней не меняются
+func (w *Watcher) Subscribe(fn func(cfg *Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Start начинает следить за каталогом конфигурации
+func (w *Watcher) Start() error {
+	dir := w.opts.Dir
+	if dir == "" {
+		dir = DefaultDir
+	}
+	env := w.opts.Env
+	if env == "" {
+		env = os.Getenv(EnvVariable)
+	}
+	files := map[string]bool{BaseFileName: true}
+	if env != "" {
+		files[fmt.Sprintf("env.%s.yaml", env)] = true
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create config watcher: %w", err)
+	}
+	// Следим за каталогом, а не за файлами: при сохранении через rename файл заменяется новым
+	if err := fsWatcher.Add(dir); err != nil {
+		_ = fsWatcher.Close()
+		return fmt.Errorf("failed to watch config directory %s: %w", dir, err)
+	}
+
+	w.fsWatcher = fsWatcher
+	w.done = make(chan struct{})
+	go w.loop(files)
+
+	log.Printf("👀 Watching configuration in %s for runtime changes", dir)
+	return nil
+}
+
+// Stop прекращает слежение
+func (w *Watcher) Stop() error {
+	if w.fsWatcher == nil {
+		return nil
+	}
+	close(w.done)
+	return w.fsWatcher.Close()
+}
+
+// loop перечитывает конфигурацию после серии изменений отслеживаемых файлов
+func (w *Watcher) loop(files map[string]bool) {
+	timer := time.NewTimer(reloadDebounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return
+			}
+			if files[filepath.Base(event.Name)] && !event.Has(fsnotify.Chmod) {
+				timer.Reset(reloadDebounce)
+			}
+		case err, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("⚠️ Config watcher error: %v", err)
+		case <-timer.C:
+			_ = w.Reload()
+		}
+	}
+}
+
+// Reload перечитывает конфигурацию и применяет изменения полей с тегом reload:"true"
+// Если новая конфигурация не загружается или не проходит Validate, текущая сохраняется
+func (w *Watcher) Reload() error {
+	next, err := Load(w.opts)
+	if err != nil {
+		log.Printf("⚠️ Config reload failed, keeping current configuration: %v", err)
+		return err
+	}
+
+	w.mu.Lock()
+	merged := *w.current
+	var applied, rejected []string
+	mergeReloadable(reflect.ValueOf(&merged).Elem(), reflect.ValueOf(next).Elem(), "", &applied, &rejected)
+
+	for _, key := range rejected {
+		log.Printf("⚠️ Config key %s changed but cannot be applied at runtime, restart required; ignoring", key)
+	}
+	if len(applied) == 0 {
+		w.mu.Unlock()
+		return nil
+	}
+
+	w.current = &merged
+	subscribers := append([]func(cfg *Config){}, w.subscribers...)
+	w.mu.Unlock()
+
+	log.Printf("🔄 Configuration reloaded: %s", strings.Join(applied, ", "))
+	for _, fn := range subscribers {
+		fn(&merged)
+	}
+	return nil
+}
+
+// mergeReloadable переносит из next в current измененные поля с тегом reload:"true"
+// Ключи примененных и отклоненных изменений добавляются в applied и rejected
+func mergeReloadable(current, next reflect.Value, prefix string, applied, rejected *[]string) {
+	t := current.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			mergeReloadable(current.Field(i), next.Field(i), key, applied, rejected)
+			continue
+		}
+		if reflect.DeepEqual(current.Field(i).Interface(), next.Field(i).Interface()) {
+			continue
+		}
+		if field.Tag.Get("reload") == "true" {
+			current.Field(i).Set(next.Field(i))
+			*applied = append(*applied, key)
+		} else {
+			*rejected = append(*rejected, key)
+		}
+	}
+}
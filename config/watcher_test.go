@@ -0,0 +1,161 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeReloadable(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(cfg *Config)
+		wantApplied  []string
+		wantRejected []string
+	}{
+		{
+			name:   "nothing changed",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			name: "reloadable fields",
+			mutate: func(cfg *Config) {
+				cfg.Log.Level = "warn"
+				cfg.Cors.AllowOrigins = []string{"https://example.com"}
+				cfg.RateLimit.Max = 10
+			},
+			wantApplied: []string{"log.level", "cors.allowOrigins", "rateLimit.max"},
+		},
+		{
+			name: "structural fields",
+			mutate: func(cfg *Config) {
+				cfg.App.Port = 9090
+				cfg.Database.Host = "db.internal"
+			},
+			wantRejected: []string{"app.port", "database.host"},
+		},
+		{
+			name: "reloadable and structural fields",
+			mutate: func(cfg *Config) {
+				cfg.Database.Host = "db.internal"
+				cfg.RateLimit.Window = time.Hour
+			},
+			wantApplied:  []string{"rateLimit.window"},
+			wantRejected: []string{"database.host"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := loadExample(t)
+			original := *current
+			next := loadExample(t)
+			tt.mutate(next)
+
+			var applied, rejected []string
+			mergeReloadable(reflect.ValueOf(current).Elem(), reflect.ValueOf(next).Elem(), "", &applied, &rejected)
+
+			if !reflect.DeepEqual(applied, tt.wantApplied) {
+				t.Errorf("applied = %q, want %q", applied, tt.wantApplied)
+			}
+			if !reflect.DeepEqual(rejected, tt.wantRejected) {
+				t.Errorf("rejected = %q, want %q", rejected, tt.wantRejected)
+			}
+
+			// Примененные поля берутся из next, отклоненные остаются прежними
+			want := original
+			tt.mutate(&want)
+			want.App.Port, want.Database.Host = original.App.Port, original.Database.Host
+			if !reflect.DeepEqual(*current, want) {
+				t.Errorf("merged config = %+v, want %+v", *current, want)
+			}
+		})
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	tests := []struct {
+		name            string
+		envFile         string
+		wantErr         bool
+		wantSubscribers int
+		wantLevel       string
+		wantPort        int
+	}{
+		{
+			name:      "nothing changed",
+			envFile:   "log:\n  level: info\n",
+			wantLevel: "info",
+			wantPort:  8080,
+		},
+		{
+			name:            "reloadable field",
+			envFile:         "log:\n  level: warn\n",
+			wantSubscribers: 1,
+			wantLevel:       "warn",
+			wantPort:        8080,
+		},
+		{
+			name:      "structural field only",
+			envFile:   "log:\n  level: info\napp:\n  port: 9090\n",
+			wantLevel: "info",
+			wantPort:  8080,
+		},
+		{
+			name:            "reloadable and structural fields",
+			envFile:         "log:\n  level: error\napp:\n  port: 9090\n",
+			wantSubscribers: 1,
+			wantLevel:       "error",
+			wantPort:        8080,
+		},
+		{
+			name:      "invalid config",
+			envFile:   "log:\n  level: verbose\n",
+			wantErr:   true,
+			wantLevel: "info",
+			wantPort:  8080,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvVariable, "")
+			dir := writeConfigDir(t, "log:\n  level: info\n")
+			opts := LoadOptions{Dir: dir, Env: "staging"}
+			cfg, err := Load(opts)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			watcher := NewWatcher(cfg, opts)
+			var calls int
+			var received *Config
+			watcher.Subscribe(func(cfg *Config) {
+				calls++
+				received = cfg
+			})
+
+			if err := os.WriteFile(filepath.Join(dir, "env.staging.yaml"), []byte(tt.envFile), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := watcher.Reload(); (err != nil) != tt.wantErr {
+				t.Fatalf("Reload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if calls != tt.wantSubscribers {
+				t.Errorf("subscriber calls = %d, want %d", calls, tt.wantSubscribers)
+			}
+			current := watcher.Current()
+			if tt.wantSubscribers == 0 && current != cfg {
+				t.Errorf("Current() replaced without applied changes")
+			}
+			if received != nil && received != current {
+				t.Errorf("subscriber received %p, Current() = %p", received, current)
+			}
+			if current.Log.Level != tt.wantLevel || current.App.Port != tt.wantPort {
+				t.Errorf("Current() log.level = %q, app.port = %d, want %q, %d",
+					current.Log.Level, current.App.Port, tt.wantLevel, tt.wantPort)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/use_case/audit_use_case"
+	"go.uber.org/fx"
+)
+
+// AuditModule — независимый DI-модуль журнала изменений
+// Recorder используется use case изменения ролей, разрешений и пользователей
+var AuditModule = fx.Options(
+	fx.Provide(
+		repositories.NewAuditLogRepository,
+		audit.NewRecorder,
+		audit_use_case.NewPaginateAuditLogUseCase,
+		handler.NewAuditHandler,
+	),
+)
@@ -0,0 +1,118 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/app/middleware"
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/use_case/auth_use_case"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/fx"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// NewTokenManager создает менеджер JWT с секретами и сроками жизни из конфигурации
+func NewTokenManager(cfg *config.Config) (*auth.TokenManager, error) {
+	accessSecret, err := tokenSecret(cfg.Auth.AccessTokenSecret, "auth.accessTokenSecret")
+	if err != nil {
+		return nil, err
+	}
+	refreshSecret, err := tokenSecret(cfg.Auth.RefreshTokenSecret, "auth.refreshTokenSecret")
+	if err != nil {
+		return nil, err
+	}
+
+	accessTTL := cfg.Auth.AccessTokenTTL
+	if accessTTL <= 0 {
+		accessTTL = defaultAccessTokenTTL
+	}
+	refreshTTL := cfg.Auth.RefreshTokenTTL
+	if refreshTTL <= 0 {
+		refreshTTL = defaultRefreshTokenTTL
+	}
+
+	issuer := cfg.Auth.Issuer
+	if issuer == "" {
+		issuer = cfg.App.Name
+	}
+
+	return auth.NewTokenManager(issuer, accessSecret, refreshSecret, accessTTL, refreshTTL), nil
+}
+
+// tokenSecret возвращает секрет из конфигурации или случайный, если он не задан
+func tokenSecret(value string, key string) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %w", key, err)
+	}
+	log.Printf("⚠️ %s is empty, using a random secret: tokens will not survive restart", key)
+	return secret, nil
+}
+
+// StartCacheListeners запускает прослушивание NOTIFY для сброса кэшей разрешений и пользователей запросов
+func StartCacheListeners(lc fx.Lifecycle, pool *pgxpool.Pool, permissions *authorization.PermissionCache, principals *authorization.PrincipalCache) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				permissions.Listen(ctx, pool)
+			}()
+			go func() {
+				defer wg.Done()
+				principals.Listen(ctx, pool)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			stats := permissions.Stats()
+			log.Printf("🔐 Permission cache: %d hits, %d misses, %d invalidations", stats.Hits, stats.Misses, stats.Invalidations)
+			return nil
+		},
+	})
+}
+
+// AuthModule — независимый DI-модуль аутентификации
+var AuthModule = fx.Options(
+	fx.Provide(
+		NewTokenManager,
+		auth_use_case.NewLoginUseCase,
+		auth_use_case.NewRefreshTokenUseCase,
+		auth_use_case.NewGetCurrentUserUseCase,
+		authorization.NewPermissionCache,
+		authorization.NewPrincipalCache,
+		authorization.NewAuthorizer,
+		middleware.NewAuthMiddleware,
+		middleware.NewPermissionMiddleware,
+		handler.NewAuthHandler,
+	),
+	fx.Invoke(StartCacheListeners),
+)
@@ -0,0 +1,51 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/config"
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"context"
+	"log"
+
+	"go.uber.org/fx"
+)
+
+// NewConfigWatcher создает наблюдатель за файлами конфигурации
+// Если следить за каталогом не удалось, приложение работает без перезагрузки настроек
+func NewConfigWatcher(lc fx.Lifecycle, cfg *config.Config, opts config.LoadOptions) *config.Watcher {
+	watcher := config.NewWatcher(cfg, opts)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := watcher.Start(); err != nil {
+				log.Printf("⚠️ Configuration hot reload disabled: %v", err)
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return watcher.Stop()
+		},
+	})
+
+	return watcher
+}
+
+// ApplyDefaultLanguage применяет i18n.defaultLanguage при старте и при изменении конфигурации
+func ApplyDefaultLanguage(cfg *config.Config, watcher *config.Watcher) {
+	apply := func(cfg *config.Config) {
+		if cfg.I18n.DefaultLanguage == "" {
+			return
+		}
+		if err := i18nPkg.SetDefaultLanguage(cfg.I18n.DefaultLanguage); err != nil {
+			log.Printf("⚠️ i18n.defaultLanguage: %v", err)
+		}
+	}
+
+	apply(cfg)
+	watcher.Subscribe(apply)
+}
+
+// ConfigModule — DI-модуль перезагрузки настроек на лету
+var ConfigModule = fx.Options(
+	fx.Provide(NewConfigWatcher),
+	fx.Invoke(ApplyDefaultLanguage),
+)
@@ -0,0 +1,76 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/db"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/fx"
+)
+
+// defaultReplicaHealthCheckInterval - период проверки реплики, если database.replica.healthCheckInterval не задан
+const defaultReplicaHealthCheckInterval = 10 * time.Second
+
+// NewDBRouter создает маршрутизатор запросов между основной БД и репликой (database.replica)
+// Недоступная при старте реплика не останавливает приложение: чтение идет на основную БД до успешной проверки
+func NewDBRouter(lc fx.Lifecycle, cfg *config.Config, primary *pgxpool.Pool) (*db.Router, error) {
+	replicaCfg := cfg.Database.Replica
+	if !replicaCfg.Enabled {
+		return db.NewRouter(primary, nil, 0), nil
+	}
+
+	replicaDatabase := cfg.Database.ReplicaDatabase()
+	poolConfig, err := NewPgPoolConfig(replicaDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("replica: %w", err)
+	}
+	log.Printf("🔌 Connecting to read replica: %s", replicaDatabase.RedactedURL())
+
+	replica, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replica pool: %w", err)
+	}
+
+	router := db.NewRouter(primary, replica, replicaCfg.MaxLag)
+	if err := router.CheckReplica(context.Background()); err != nil {
+		log.Printf("⚠️ Read replica is not available yet, reads go to primary: %v", err)
+	}
+
+	interval := replicaCfg.HealthCheckInterval
+	if interval == 0 {
+		interval = defaultReplicaHealthCheckInterval
+	}
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go router.WatchReplica(watchCtx, interval)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stopWatch()
+			log.Println("🔌 Closing read replica connection...")
+			replica.Close()
+			return nil
+		},
+	})
+
+	return router, nil
+}
+
+// NewTxManager создает TxManager с уровнем изоляции и числом повторов из database.transaction
+// Уровень изоляции по умолчанию - read committed
+func NewTxManager(cfg *config.Config, pool *pgxpool.Pool) *db.TxManager {
+	txCfg := cfg.Database.Transaction
+	isoLevel := pgx.ReadCommitted
+	if txCfg.IsolationLevel != "" {
+		isoLevel = pgx.TxIsoLevel(strings.ReplaceAll(txCfg.IsolationLevel, "_", " "))
+	}
+	return db.NewTxManager(pool, db.TxOptions{IsoLevel: isoLevel}, txCfg.MaxRetries)
+}
@@ -1,26 +1,36 @@
 package dependecy_injection
 
 import (
+	"clean_architecture_fiber/app/middleware"
 	"clean_architecture_fiber/app/route"
+	"clean_architecture_fiber/app/route/handler"
 	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/db"
 	"clean_architecture_fiber/data/db/generated"
 	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
+	"strconv"
 
 	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"clean_architecture_fiber/pkg/pagination"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/fx"
 )
 
 // NewFiberApp создает и настраивает экземпляр Fiber приложения
-func NewFiberApp(cfg *config.Config) *fiber.App {
+func NewFiberApp(
+	cfg *config.Config,
+	requestLog *middleware.RequestLogMiddleware,
+	corsMiddleware *middleware.CorsMiddleware,
+	rateLimit *middleware.RateLimitMiddleware,
+) *fiber.App {
 	app := fiber.New(fiber.Config{
 		Prefork:               cfg.Fiber.Prefork,
 		CaseSensitive:         cfg.Fiber.CaseSensitive,
@@ -36,27 +46,30 @@ func NewFiberApp(cfg *config.Config) *fiber.App {
 		Immutable:             cfg.Fiber.Immutable,
 		ProxyHeader:           cfg.Fiber.ProxyHeader,
 		DisableStartupMessage: cfg.Fiber.DisableStartupMessage,
+		ErrorHandler:          handler.ErrorHandler,
 	})
 
 	// Устанавливаем глобальные middleware
-	setupMiddleware(app)
+	setupMiddleware(app, requestLog, corsMiddleware, rateLimit)
 
 	return app
 }
 
 // setupMiddleware настраивает глобальные middleware для приложения
-func setupMiddleware(app *fiber.App) {
+// Логирование, CORS и ограничение частоты запросов перенастраиваются на лету (config.Watcher)
+func setupMiddleware(
+	app *fiber.App,
+	requestLog *middleware.RequestLogMiddleware,
+	corsMiddleware *middleware.CorsMiddleware,
+	rateLimit *middleware.RateLimitMiddleware,
+) {
 	// Recover middleware - восстановление после паники
 	app.Use(recover.New(recover.Config{
 		EnableStackTrace: true,
 	}))
 
-	// Logger middleware - логирование HTTP запросов
-	app.Use(logger.New(logger.Config{
-		Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
-		TimeFormat: "2006-01-02 15:04:05",
-		TimeZone:   "Local",
-	}))
+	// Logger middleware - логирование HTTP запросов с учетом log.level
+	app.Use(requestLog.Handle())
 
 	// RequestID middleware - добавление уникального ID к каждому запросу
 	app.Use(requestid.New())
@@ -64,13 +77,14 @@ func setupMiddleware(app *fiber.App) {
 	// I18n middleware - определение языка запроса
 	app.Use(i18nPkg.Middleware())
 
-	// CORS middleware - настройка Cross-Origin Resource Sharing
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     "*",
-		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, Accept-Language",
-		AllowCredentials: false,
-	}))
+	// CORS middleware - настройка Cross-Origin Resource Sharing (cors.allowOrigins)
+	app.Use(corsMiddleware.Handle())
+
+	// Limiter middleware - ограничение частоты запросов с одного IP (rateLimit)
+	app.Use(rateLimit.Handle())
+
+	// Read your writes - запись и заголовок X-Read-Your-Writes читают с основной БД, а не с реплики
+	app.Use(middleware.ReadYourWrites())
 
 	// Compress middleware - сжатие ответов
 	app.Use(compress.New(compress.Config{
@@ -82,10 +96,14 @@ func setupMiddleware(app *fiber.App) {
 func NewPgPool(lc fx.Lifecycle, cfg *config.Config) (*pgxpool.Pool, error) {
 	ctx := context.Background()
 
-	dsn := cfg.GetDatabaseURL()
-	log.Printf("🔌 Connecting to database: %s", cfg.Database.Name)
+	poolConfig, err := NewPgPoolConfig(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("🔌 Connecting to database: %s (pool: max %d, min %d conns)",
+		cfg.RedactedDatabaseURL(), poolConfig.MaxConns, poolConfig.MinConns)
 
-	pool, err := pgxpool.New(ctx, dsn)
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -110,6 +128,61 @@ func NewPgPool(lc fx.Lifecycle, cfg *config.Config) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
+// NewPgPoolConfig собирает конфигурацию пула из DSN и секции database
+// Нулевые значения параметров пула оставляют значения pgxpool по умолчанию
+func NewPgPoolConfig(database config.DatabaseConfig) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(database.URL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+
+	if database.Pool.MaxConns > 0 {
+		poolConfig.MaxConns = database.Pool.MaxConns
+	}
+	if database.Pool.MinConns > 0 {
+		poolConfig.MinConns = database.Pool.MinConns
+	}
+	if database.Pool.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = database.Pool.MaxConnLifetime
+	}
+	if database.Pool.MaxConnLifetimeJitter > 0 {
+		poolConfig.MaxConnLifetimeJitter = database.Pool.MaxConnLifetimeJitter
+	}
+	if database.Pool.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = database.Pool.MaxConnIdleTime
+	}
+	if database.Pool.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = database.Pool.HealthCheckPeriod
+	}
+	if database.ConnectTimeout > 0 {
+		poolConfig.ConnConfig.ConnectTimeout = database.ConnectTimeout
+	}
+
+	// statement_timeout передается в стартовом пакете и действует для всей сессии
+	if database.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(database.StatementTimeout.Milliseconds(), 10)
+	}
+
+	// Настройки сессии применяются к каждому новому соединению пула
+	if database.SearchPath != "" || database.TimeZone != "" {
+		poolConfig.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			if database.SearchPath != "" {
+				if _, err := conn.Exec(ctx, "SELECT set_config('search_path', $1, false)", database.SearchPath); err != nil {
+					return fmt.Errorf("failed to set search_path: %w", err)
+				}
+			}
+			if database.TimeZone != "" {
+				if _, err := conn.Exec(ctx, "SELECT set_config('TimeZone', $1, false)", database.TimeZone); err != nil {
+					return fmt.Errorf("failed to set timezone: %w", err)
+				}
+			}
+			return nil
+		}
+	}
+
+	return poolConfig, nil
+}
+
 // StartFiberServer запускает Fiber сервер
 func StartFiberServer(lc fx.Lifecycle, app *fiber.App, cfg *config.Config) {
 	lc.Append(fx.Hook{
@@ -133,18 +206,47 @@ func StartFiberServer(lc fx.Lifecycle, app *fiber.App, cfg *config.Config) {
 	})
 }
 
-// NewQueries создает экземпляр generated.Queries из пула подключений
-func NewQueries(pool *pgxpool.Pool) *generated.Queries {
-	return generated.New(pool)
+// NewCursorCodec создает кодек курсоров keyset пагинации с секретом из конфигурации
+func NewCursorCodec(cfg *config.Config) (*pagination.CursorCodec, error) {
+	secret := []byte(cfg.Pagination.CursorSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate cursor secret: %w", err)
+		}
+		log.Println("⚠️ pagination.cursorSecret is empty, using a random secret: cursors will not survive restart")
+	}
+	return pagination.NewCursorCodec(secret), nil
+}
+
+// NewQueries создает экземпляр generated.Queries поверх маршрутизатора основная БД / реплика
+func NewQueries(router *db.Router) *generated.Queries {
+	return generated.New(router)
 }
 
 var AppModule = fx.Options(
 	fx.Provide(
 		NewFiberApp,
 		NewPgPool,
+		NewDBRouter,
+		NewTxManager,
 		NewQueries,
+		NewCursorCodec,
+		middleware.NewRequestLogMiddleware,
+		middleware.NewCorsMiddleware,
+		middleware.NewRateLimitMiddleware,
 	),
+	ConfigModule,
+	// Миграции и сидеры регистрируются до остальных модулей, чтобы их хуки запуска выполнились первыми
+	MigrationModule,
+	SeedModule,
 	RoleModule, // сюда входят все домены
+	PermissionModule,
+	RolePermissionModule,
+	UserModule,
+	AuthModule,
+	AuditModule,
+	TrashModule,
 	fx.Invoke(route.SetupRoutes),
 	fx.Invoke(StartFiberServer),
 )
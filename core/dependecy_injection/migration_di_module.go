@@ -0,0 +1,37 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/db"
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/fx"
+)
+
+// RunMigrationsOnStart применяет встроенные миграции при старте, если включен database.autoMigrate
+// Хук регистрируется раньше остальных, поэтому выполняется до сидеров, кэша разрешений и HTTP сервера
+func RunMigrationsOnStart(lc fx.Lifecycle, pool *pgxpool.Pool, cfg *config.Config) error {
+	if !cfg.Database.AutoMigrate {
+		return nil
+	}
+
+	migrator, err := db.NewMigrator(pool)
+	if err != nil {
+		return err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("📦 Running database migrations...")
+			return migrator.Up(ctx)
+		},
+	})
+	return nil
+}
+
+// MigrationModule — DI-модуль автоматического применения миграций
+var MigrationModule = fx.Options(
+	fx.Invoke(RunMigrationsOnStart),
+)
@@ -0,0 +1,28 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/use_case/permission_use_case"
+	"go.uber.org/fx"
+)
+
+// PermissionModule — независимый DI-модуль для домена "Permission"
+var PermissionModule = fx.Options(
+	fx.Provide(
+		repositories.NewPermissionRepository,
+		permission_use_case.NewGetPermissionByValueUseCase,
+		permission_use_case.NewGetPermissionByIdUseCase,
+		permission_use_case.NewCreatePermissionUseCase,
+		permission_use_case.NewUpdatePermissionUseCase,
+		permission_use_case.NewDeletePermissionUseCase,
+		permission_use_case.NewRestorePermissionUseCase,
+		permission_use_case.NewHardDeletePermissionUseCase,
+		permission_use_case.NewListPermissionsUseCase,
+		permission_use_case.NewPaginatePermissionsUseCase,
+		permission_use_case.NewSeekPermissionsUseCase,
+		permission_use_case.NewImportPermissionsUseCase,
+		permission_use_case.NewExportPermissionsUseCase,
+		handler.NewPermissionHandler,
+	),
+)
@@ -12,6 +12,17 @@ var RoleModule = fx.Options(
 	fx.Provide(
 		repositories.NewRoleRepository,
 		role_use_case.NewGetRoleByValueUseCase,
+		role_use_case.NewGetRoleByIdUseCase,
+		role_use_case.NewCreateRoleUseCase,
+		role_use_case.NewUpdateRoleUseCase,
+		role_use_case.NewDeleteRoleUseCase,
+		role_use_case.NewRestoreRoleUseCase,
+		role_use_case.NewHardDeleteRoleUseCase,
+		role_use_case.NewListRolesUseCase,
+		role_use_case.NewPaginateRolesUseCase,
+		role_use_case.NewSeekRolesUseCase,
+		role_use_case.NewImportRolesUseCase,
+		role_use_case.NewExportRolesUseCase,
 		handler.NewRoleHandler,
 	),
 )
@@ -0,0 +1,25 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/use_case/role_permission_use_case"
+	"go.uber.org/fx"
+)
+
+// RolePermissionModule — независимый DI-модуль для связей "Role" <-> "Permission"
+var RolePermissionModule = fx.Options(
+	fx.Provide(
+		repositories.NewRolePermissionEvents,
+		repositories.NewRolePermissionRepository,
+		role_permission_use_case.NewGetRolePermissionsUseCase,
+		role_permission_use_case.NewGetPermissionRolesUseCase,
+		role_permission_use_case.NewAssignPermissionsToRoleUseCase,
+		role_permission_use_case.NewRemovePermissionsFromRoleUseCase,
+		role_permission_use_case.NewReplaceRolePermissionsUseCase,
+		role_permission_use_case.NewCheckRoleHasPermissionUseCase,
+		role_permission_use_case.NewPaginateRolePermissionsUseCase,
+		role_permission_use_case.NewSeekRolePermissionsUseCase,
+		handler.NewRolePermissionHandler,
+	),
+)
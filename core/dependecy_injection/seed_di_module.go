@@ -0,0 +1,42 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/data/seeders"
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/fx"
+)
+
+// RunSeedersOnStart применяет фикстуры при старте, если включен database.autoSeed
+// Ошибка сидирования логируется и не останавливает приложение
+func RunSeedersOnStart(lc fx.Lifecycle, pool *pgxpool.Pool, cfg *config.Config) {
+	if !cfg.Database.AutoSeed {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("🌱 Running database seeders...")
+			report, err := seeders.Run(ctx, pool, seeders.RunOptions{
+				SeedOptions: seeders.SeedOptions{
+					Admin: seeders.AdminOptions{Email: cfg.Auth.Admin.Email, Password: cfg.Auth.Admin.Password},
+				},
+				Env: cfg.App.Env,
+			})
+			if err != nil {
+				log.Printf("❌ Database seeding failed: %v", err)
+				return nil
+			}
+			log.Printf("🌱 All seeders executed successfully! %s", report)
+			return nil
+		},
+	})
+}
+
+// SeedModule — DI-модуль автоматического сидирования при старте
+var SeedModule = fx.Options(
+	fx.Invoke(RunSeedersOnStart),
+)
@@ -0,0 +1,59 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/config"
+	"clean_architecture_fiber/domain/trash"
+	"clean_architecture_fiber/domain/use_case/trash_use_case"
+	"context"
+	"log"
+
+	"go.uber.org/fx"
+)
+
+// trashSettings возвращает актуальные параметры очистки корзины из конфигурации
+func trashSettings(watcher *config.Watcher) func() trash.Settings {
+	return func() trash.Settings {
+		cfg := watcher.Current().Trash
+		return trash.Settings{
+			Enabled:   cfg.PurgeEnabled,
+			Retention: cfg.Retention,
+			Interval:  cfg.PurgeInterval,
+			BatchSize: cfg.BatchSize,
+		}
+	}
+}
+
+// StartTrashPurger запускает периодическую очистку корзины (trash.purgeEnabled)
+// Параметры перечитываются перед каждым запуском, поэтому очистку можно включить без перезапуска
+func StartTrashPurger(lc fx.Lifecycle, watcher *config.Watcher, purger *trash.Purger) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				purger.Run(ctx, trashSettings(watcher))
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			log.Println("🗑️ Trash purger stopped")
+			return nil
+		},
+	})
+}
+
+// TrashModule — независимый DI-модуль очистки корзины
+var TrashModule = fx.Options(
+	fx.Provide(
+		trash.NewPurger,
+		trash_use_case.NewGetLastPurgeUseCase,
+	),
+	fx.Invoke(StartTrashPurger),
+)
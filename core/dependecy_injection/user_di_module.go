@@ -0,0 +1,33 @@
+package dependecy_injection
+
+import (
+	"clean_architecture_fiber/app/route/handler"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/use_case/user_role_use_case"
+	"clean_architecture_fiber/domain/use_case/user_use_case"
+	"go.uber.org/fx"
+)
+
+// UserModule — независимый DI-модуль для домена "User" и связей "User" <-> "Role"
+var UserModule = fx.Options(
+	fx.Provide(
+		repositories.NewUserEvents,
+		repositories.NewUserRepository,
+		repositories.NewUserRoleRepository,
+		user_use_case.NewGetUserByIdUseCase,
+		user_use_case.NewCreateUserUseCase,
+		user_use_case.NewUpdateUserUseCase,
+		user_use_case.NewUpdateUserCredentialsUseCase,
+		user_use_case.NewDeleteUserUseCase,
+		user_use_case.NewRestoreUserUseCase,
+		user_use_case.NewHardDeleteUserUseCase,
+		user_use_case.NewPaginateUsersUseCase,
+		user_role_use_case.NewGetUserRolesUseCase,
+		user_role_use_case.NewAssignRolesToUserUseCase,
+		user_role_use_case.NewRemoveRolesFromUserUseCase,
+		user_role_use_case.NewReplaceUserRolesUseCase,
+		user_role_use_case.NewCheckUserHasPermissionUseCase,
+		handler.NewUserHandler,
+		handler.NewUserRoleHandler,
+	),
+)
@@ -8,6 +8,19 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID         pgtype.UUID      `json:"id"`
+	ActorID    pgtype.UUID      `json:"actor_id"`
+	ActorEmail pgtype.Text      `json:"actor_email"`
+	Action     string           `json:"action"`
+	EntityType string           `json:"entity_type"`
+	EntityID   pgtype.UUID      `json:"entity_id"`
+	Before     []byte           `json:"before"`
+	After      []byte           `json:"after"`
+	RequestID  pgtype.Text      `json:"request_id"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
 type Permission struct {
 	ID            pgtype.UUID      `json:"id"`
 	TitleRu       string           `json:"title_ru"`
@@ -42,3 +55,21 @@ type RolePermission struct {
 	PermissionID pgtype.UUID      `json:"permission_id"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 }
+
+type User struct {
+	ID           pgtype.UUID      `json:"id"`
+	Email        string           `json:"email"`
+	PasswordHash string           `json:"password_hash"`
+	FullName     pgtype.Text      `json:"full_name"`
+	IsActive     bool             `json:"is_active"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+}
+
+type UserRole struct {
+	ID        pgtype.UUID      `json:"id"`
+	UserID    pgtype.UUID      `json:"user_id"`
+	RoleID    pgtype.UUID      `json:"role_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
@@ -78,31 +78,34 @@ FROM permissions p
 WHERE
     -- show_deleted filter
     (CASE WHEN $1::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND ($2::boolean IS NOT TRUE OR p.deleted_at IS NOT NULL)
     -- search filter
     AND (
-        $2::text IS NULL OR
-        p.title_ru ILIKE '%' || $2 || '%' OR
-        p.title_en ILIKE '%' || $2 || '%' OR
-        p.title_kk ILIKE '%' || $2 || '%' OR
-        p.description_ru ILIKE '%' || $2 || '%' OR
-        p.description_en ILIKE '%' || $2 || '%' OR
-        p.description_kk ILIKE '%' || $2 || '%' OR
-        p.value ILIKE '%' || $2 || '%'
+        $3::text IS NULL OR
+        p.title_ru ILIKE '%' || $3 || '%' OR
+        p.title_en ILIKE '%' || $3 || '%' OR
+        p.title_kk ILIKE '%' || $3 || '%' OR
+        p.description_ru ILIKE '%' || $3 || '%' OR
+        p.description_en ILIKE '%' || $3 || '%' OR
+        p.description_kk ILIKE '%' || $3 || '%' OR
+        p.value ILIKE '%' || $3 || '%'
     )
     -- values filter
     AND (
-        $3::text[] IS NULL OR
-        p.value = ANY($3::text[])
+        $4::text[] IS NULL OR
+        p.value = ANY($4::text[])
     )
     -- ids filter
     AND (
-        $4::uuid[] IS NULL OR
-        p.id = ANY($4::uuid[])
+        $5::uuid[] IS NULL OR
+        p.id = ANY($5::uuid[])
     )
 `
 
 type CountAllPermissionsParams struct {
 	ShowDeleted pgtype.Bool   `json:"show_deleted"`
+	OnlyDeleted pgtype.Bool   `json:"only_deleted"`
 	Search      pgtype.Text   `json:"search"`
 	Values      []string      `json:"values"`
 	Ids         []pgtype.UUID `json:"ids"`
@@ -111,6 +114,7 @@ type CountAllPermissionsParams struct {
 func (q *Queries) CountAllPermissions(ctx context.Context, arg CountAllPermissionsParams) (int64, error) {
 	row := q.db.QueryRow(ctx, countAllPermissions,
 		arg.ShowDeleted,
+		arg.OnlyDeleted,
 		arg.Search,
 		arg.Values,
 		arg.Ids,
@@ -517,6 +521,159 @@ func (q *Queries) ListAllPermissions(ctx context.Context, arg ListAllPermissions
 	return items, nil
 }
 
+const listPermissionsForExport = `-- name: ListPermissionsForExport :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM permissions
+WHERE deleted_at IS NULL
+ORDER BY value
+`
+
+// Все неудаленные записи для экспорта, по value
+func (q *Queries) ListPermissionsForExport(ctx context.Context) ([]Permission, error) {
+	rows, err := q.db.Query(ctx, listPermissionsForExport)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Permission{}
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockExpiredDeletedPermissions = `-- name: LockExpiredDeletedPermissions :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM permissions
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < now() - $1::interval
+ORDER BY deleted_at
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type LockExpiredDeletedPermissionsParams struct {
+	Retention pgtype.Interval `json:"retention"`
+	Limit     int32           `json:"limit"`
+}
+
+// Мягко удаленные записи старше retention для очистки корзины, самые старые первыми
+// Строки, заблокированные другой транзакцией (например, восстановлением), пропускаются
+func (q *Queries) LockExpiredDeletedPermissions(ctx context.Context, arg LockExpiredDeletedPermissionsParams) ([]Permission, error) {
+	rows, err := q.db.Query(ctx, lockExpiredDeletedPermissions, arg.Retention, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Permission{}
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPermissionById = `-- name: LockPermissionById :one
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM permissions
+WHERE id = $1
+FOR UPDATE
+`
+
+// Блокирует строку (в том числе мягко удаленную) до конца транзакции
+func (q *Queries) LockPermissionById(ctx context.Context, id pgtype.UUID) (Permission, error) {
+	row := q.db.QueryRow(ctx, lockPermissionById, id)
+	var i Permission
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const lockPermissionsByValues = `-- name: LockPermissionsByValues :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM permissions
+WHERE value = ANY($1::text[])
+ORDER BY value
+FOR UPDATE
+`
+
+// Блокирует строки с указанными value (в том числе мягко удаленные) до конца транзакции
+func (q *Queries) LockPermissionsByValues(ctx context.Context, values []string) ([]Permission, error) {
+	rows, err := q.db.Query(ctx, lockPermissionsByValues, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Permission{}
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const paginateAllPermissions = `-- name: PaginateAllPermissions :many
 SELECT p.id, p.title_ru, p.title_en, p.title_kk, p.description_ru, p.description_kk, p.description_en, p.value, p.created_at, p.updated_at, p.deleted_at,
        COALESCE(
@@ -542,43 +699,46 @@ LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
 WHERE
     -- show_deleted filter
     (CASE WHEN $1::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND ($2::boolean IS NOT TRUE OR p.deleted_at IS NOT NULL)
     -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
     AND (
-        $2::text IS NULL OR
-        p.title_ru ILIKE '%' || $2 || '%' OR
-        p.title_en ILIKE '%' || $2 || '%' OR
-        p.title_kk ILIKE '%' || $2 || '%' OR
-        p.description_ru ILIKE '%' || $2 || '%' OR
-        p.description_en ILIKE '%' || $2 || '%' OR
-        p.description_kk ILIKE '%' || $2 || '%' OR
-        p.value ILIKE '%' || $2 || '%'
+        $3::text IS NULL OR
+        p.title_ru ILIKE '%' || $3 || '%' OR
+        p.title_en ILIKE '%' || $3 || '%' OR
+        p.title_kk ILIKE '%' || $3 || '%' OR
+        p.description_ru ILIKE '%' || $3 || '%' OR
+        p.description_en ILIKE '%' || $3 || '%' OR
+        p.description_kk ILIKE '%' || $3 || '%' OR
+        p.value ILIKE '%' || $3 || '%'
     )
     -- values filter
     AND (
-        $3::text[] IS NULL OR
-        p.value = ANY($3::text[])
+        $4::text[] IS NULL OR
+        p.value = ANY($4::text[])
     )
     -- ids filter
     AND (
-        $4::uuid[] IS NULL OR
-        p.id = ANY($4::uuid[])
+        $5::uuid[] IS NULL OR
+        p.id = ANY($5::uuid[])
     )
 GROUP BY p.id
 ORDER BY
-    CASE WHEN $5 = 'created_at' AND $6 = 'ASC' THEN p.created_at END ASC,
-    CASE WHEN $5 = 'created_at' AND $6 = 'DESC' THEN p.created_at END DESC,
-    CASE WHEN $5 = 'updated_at' AND $6 = 'ASC' THEN p.updated_at END ASC,
-    CASE WHEN $5 = 'updated_at' AND $6 = 'DESC' THEN p.updated_at END DESC,
-    CASE WHEN $5 = 'title_ru' AND $6 = 'ASC' THEN p.title_ru END ASC,
-    CASE WHEN $5 = 'title_ru' AND $6 = 'DESC' THEN p.title_ru END DESC,
-    CASE WHEN $5 = 'value' AND $6 = 'ASC' THEN p.value END ASC,
-    CASE WHEN $5 = 'value' AND $6 = 'DESC' THEN p.value END DESC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'ASC' THEN p.created_at END ASC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'DESC' THEN p.created_at END DESC,
+    CASE WHEN $6 = 'updated_at' AND $7 = 'ASC' THEN p.updated_at END ASC,
+    CASE WHEN $6 = 'updated_at' AND $7 = 'DESC' THEN p.updated_at END DESC,
+    CASE WHEN $6 = 'title_ru' AND $7 = 'ASC' THEN p.title_ru END ASC,
+    CASE WHEN $6 = 'title_ru' AND $7 = 'DESC' THEN p.title_ru END DESC,
+    CASE WHEN $6 = 'value' AND $7 = 'ASC' THEN p.value END ASC,
+    CASE WHEN $6 = 'value' AND $7 = 'DESC' THEN p.value END DESC,
     p.created_at DESC
-LIMIT $8 OFFSET $7
+LIMIT $9 OFFSET $8
 `
 
 type PaginateAllPermissionsParams struct {
 	ShowDeleted pgtype.Bool   `json:"show_deleted"`
+	OnlyDeleted pgtype.Bool   `json:"only_deleted"`
 	Search      pgtype.Text   `json:"search"`
 	Values      []string      `json:"values"`
 	Ids         []pgtype.UUID `json:"ids"`
@@ -606,6 +766,7 @@ type PaginateAllPermissionsRow struct {
 func (q *Queries) PaginateAllPermissions(ctx context.Context, arg PaginateAllPermissionsParams) ([]PaginateAllPermissionsRow, error) {
 	rows, err := q.db.Query(ctx, paginateAllPermissions,
 		arg.ShowDeleted,
+		arg.OnlyDeleted,
 		arg.Search,
 		arg.Values,
 		arg.Ids,
@@ -645,6 +806,281 @@ func (q *Queries) PaginateAllPermissions(ctx context.Context, arg PaginateAllPer
 	return items, nil
 }
 
+const restorePermissionById = `-- name: RestorePermissionById :one
+UPDATE permissions
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at
+`
+
+func (q *Queries) RestorePermissionById(ctx context.Context, id pgtype.UUID) (Permission, error) {
+	row := q.db.QueryRow(ctx, restorePermissionById, id)
+	var i Permission
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const seekPermissionsAfter = `-- name: SeekPermissionsAfter :many
+
+SELECT p.id, p.title_ru, p.title_en, p.title_kk, p.description_ru, p.description_kk, p.description_en, p.value, p.created_at, p.updated_at, p.deleted_at,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', r.id,
+                   'title_ru', r.title_ru,
+                   'title_en', r.title_en,
+                   'title_kk', r.title_kk,
+                   'value', r.value,
+                   'description_ru', r.description_ru,
+                   'description_en', r.description_en,
+                   'description_kk', r.description_kk,
+                   'created_at', r.created_at,
+                   'updated_at', r.updated_at,
+                   'deleted_at', r.deleted_at
+               )
+           ) FILTER (WHERE r.id IS NOT NULL), '[]'
+       ) as roles
+FROM permissions p
+LEFT JOIN role_permissions rp ON p.id = rp.permission_id
+LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN $1::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        $2::text IS NULL OR
+        p.title_ru ILIKE '%' || $2 || '%' OR
+        p.title_en ILIKE '%' || $2 || '%' OR
+        p.title_kk ILIKE '%' || $2 || '%' OR
+        p.description_ru ILIKE '%' || $2 || '%' OR
+        p.description_en ILIKE '%' || $2 || '%' OR
+        p.description_kk ILIKE '%' || $2 || '%' OR
+        p.value ILIKE '%' || $2 || '%'
+    )
+    -- values filter
+    AND (
+        $3::text[] IS NULL OR
+        p.value = ANY($3::text[])
+    )
+    -- ids filter
+    AND (
+        $4::uuid[] IS NULL OR
+        p.id = ANY($4::uuid[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (p.created_at, p.id) < ($5::timestamp, $6::uuid)
+    )
+GROUP BY p.id
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $7
+`
+
+type SeekPermissionsAfterParams struct {
+	ShowDeleted     pgtype.Bool      `json:"show_deleted"`
+	Search          pgtype.Text      `json:"search"`
+	Values          []string         `json:"values"`
+	Ids             []pgtype.UUID    `json:"ids"`
+	CursorCreatedAt pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID        pgtype.UUID      `json:"cursor_id"`
+	Limit           int32            `json:"limit"`
+}
+
+type SeekPermissionsAfterRow struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	Value         string           `json:"value"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
+	Roles         interface{}      `json:"roles"`
+}
+
+// ============================================================================
+// KEYSET (CURSOR) PAGINATION
+// ============================================================================
+func (q *Queries) SeekPermissionsAfter(ctx context.Context, arg SeekPermissionsAfterParams) ([]SeekPermissionsAfterRow, error) {
+	rows, err := q.db.Query(ctx, seekPermissionsAfter,
+		arg.ShowDeleted,
+		arg.Search,
+		arg.Values,
+		arg.Ids,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekPermissionsAfterRow{}
+	for rows.Next() {
+		var i SeekPermissionsAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Roles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seekPermissionsBefore = `-- name: SeekPermissionsBefore :many
+SELECT p.id, p.title_ru, p.title_en, p.title_kk, p.description_ru, p.description_kk, p.description_en, p.value, p.created_at, p.updated_at, p.deleted_at,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', r.id,
+                   'title_ru', r.title_ru,
+                   'title_en', r.title_en,
+                   'title_kk', r.title_kk,
+                   'value', r.value,
+                   'description_ru', r.description_ru,
+                   'description_en', r.description_en,
+                   'description_kk', r.description_kk,
+                   'created_at', r.created_at,
+                   'updated_at', r.updated_at,
+                   'deleted_at', r.deleted_at
+               )
+           ) FILTER (WHERE r.id IS NOT NULL), '[]'
+       ) as roles
+FROM permissions p
+LEFT JOIN role_permissions rp ON p.id = rp.permission_id
+LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN $1::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        $2::text IS NULL OR
+        p.title_ru ILIKE '%' || $2 || '%' OR
+        p.title_en ILIKE '%' || $2 || '%' OR
+        p.title_kk ILIKE '%' || $2 || '%' OR
+        p.description_ru ILIKE '%' || $2 || '%' OR
+        p.description_en ILIKE '%' || $2 || '%' OR
+        p.description_kk ILIKE '%' || $2 || '%' OR
+        p.value ILIKE '%' || $2 || '%'
+    )
+    -- values filter
+    AND (
+        $3::text[] IS NULL OR
+        p.value = ANY($3::text[])
+    )
+    -- ids filter
+    AND (
+        $4::uuid[] IS NULL OR
+        p.id = ANY($4::uuid[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (p.created_at, p.id) > ($5::timestamp, $6::uuid)
+    )
+GROUP BY p.id
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT $7
+`
+
+type SeekPermissionsBeforeParams struct {
+	ShowDeleted     pgtype.Bool      `json:"show_deleted"`
+	Search          pgtype.Text      `json:"search"`
+	Values          []string         `json:"values"`
+	Ids             []pgtype.UUID    `json:"ids"`
+	CursorCreatedAt pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID        pgtype.UUID      `json:"cursor_id"`
+	Limit           int32            `json:"limit"`
+}
+
+type SeekPermissionsBeforeRow struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	Value         string           `json:"value"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
+	Roles         interface{}      `json:"roles"`
+}
+
+func (q *Queries) SeekPermissionsBefore(ctx context.Context, arg SeekPermissionsBeforeParams) ([]SeekPermissionsBeforeRow, error) {
+	rows, err := q.db.Query(ctx, seekPermissionsBefore,
+		arg.ShowDeleted,
+		arg.Search,
+		arg.Values,
+		arg.Ids,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekPermissionsBeforeRow{}
+	for rows.Next() {
+		var i SeekPermissionsBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Roles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePermissionById = `-- name: UpdatePermissionById :one
 UPDATE permissions
 SET title_ru = $2,
@@ -697,3 +1133,60 @@ func (q *Queries) UpdatePermissionById(ctx context.Context, arg UpdatePermission
 	)
 	return i, err
 }
+
+const updatePermissionByIdIfUnmodified = `-- name: UpdatePermissionByIdIfUnmodified :one
+UPDATE permissions
+SET title_ru = $2,
+    title_en = $3,
+    title_kk = $4,
+    description_ru = $5,
+    description_en = $6,
+    description_kk = $7,
+    value = $8,
+    updated_at = greatest(now()::timestamp, updated_at + interval '1 microsecond')
+WHERE id = $1 AND deleted_at IS NULL AND updated_at = $9
+RETURNING id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at
+`
+
+type UpdatePermissionByIdIfUnmodifiedParams struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	Value         string           `json:"value"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+// Оптимистичная блокировка: строка обновляется, только если updated_at не изменился с момента чтения
+// updated_at строго растет, поэтому ETag меняется даже при двух обновлениях в одну микросекунду
+func (q *Queries) UpdatePermissionByIdIfUnmodified(ctx context.Context, arg UpdatePermissionByIdIfUnmodifiedParams) (Permission, error) {
+	row := q.db.QueryRow(ctx, updatePermissionByIdIfUnmodified,
+		arg.ID,
+		arg.TitleRu,
+		arg.TitleEn,
+		arg.TitleKk,
+		arg.DescriptionRu,
+		arg.DescriptionEn,
+		arg.DescriptionKk,
+		arg.Value,
+		arg.UpdatedAt,
+	)
+	var i Permission
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
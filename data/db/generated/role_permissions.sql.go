@@ -943,3 +943,241 @@ func (q *Queries) RemovePermissionFromRole(ctx context.Context, arg RemovePermis
 	_, err := q.db.Exec(ctx, removePermissionFromRole, arg.RoleID, arg.PermissionID)
 	return err
 }
+
+const seekRolePermissionsAfter = `-- name: SeekRolePermissionsAfter :many
+
+SELECT rp.id, rp.role_id, rp.permission_id, rp.created_at,
+       json_build_object(
+           'id', r.id,
+           'title_ru', r.title_ru,
+           'title_en', r.title_en,
+           'title_kk', r.title_kk,
+           'value', r.value,
+           'description_ru', r.description_ru,
+           'description_en', r.description_en,
+           'description_kk', r.description_kk,
+           'created_at', r.created_at,
+           'updated_at', r.updated_at,
+           'deleted_at', r.deleted_at
+       ) as role,
+       json_build_object(
+           'id', p.id,
+           'title_ru', p.title_ru,
+           'title_en', p.title_en,
+           'title_kk', p.title_kk,
+           'value', p.value,
+           'description_ru', p.description_ru,
+           'description_en', p.description_en,
+           'description_kk', p.description_kk,
+           'created_at', p.created_at,
+           'updated_at', p.updated_at,
+           'deleted_at', p.deleted_at
+       ) as permission
+FROM role_permissions rp
+INNER JOIN roles r ON rp.role_id = r.id
+INNER JOIN permissions p ON rp.permission_id = p.id
+WHERE
+    r.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+    -- role_ids filter
+    AND (
+        $1::uuid[] IS NULL OR
+        rp.role_id = ANY($1::uuid[])
+    )
+    -- permission_ids filter
+    AND (
+        $2::uuid[] IS NULL OR
+        rp.permission_id = ANY($2::uuid[])
+    )
+    -- role_values filter
+    AND (
+        $3::text[] IS NULL OR
+        r.value = ANY($3::text[])
+    )
+    -- permission_values filter
+    AND (
+        $4::text[] IS NULL OR
+        p.value = ANY($4::text[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (rp.created_at, rp.id) < ($5::timestamp, $6::uuid)
+    )
+ORDER BY rp.created_at DESC, rp.id DESC
+LIMIT $7
+`
+
+type SeekRolePermissionsAfterParams struct {
+	RoleIds          []pgtype.UUID    `json:"role_ids"`
+	PermissionIds    []pgtype.UUID    `json:"permission_ids"`
+	RoleValues       []string         `json:"role_values"`
+	PermissionValues []string         `json:"permission_values"`
+	CursorCreatedAt  pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID         pgtype.UUID      `json:"cursor_id"`
+	Limit            int32            `json:"limit"`
+}
+
+type SeekRolePermissionsAfterRow struct {
+	ID           pgtype.UUID      `json:"id"`
+	RoleID       pgtype.UUID      `json:"role_id"`
+	PermissionID pgtype.UUID      `json:"permission_id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	Role         []byte           `json:"role"`
+	Permission   []byte           `json:"permission"`
+}
+
+// ============================================================================
+// KEYSET (CURSOR) PAGINATION
+// ============================================================================
+func (q *Queries) SeekRolePermissionsAfter(ctx context.Context, arg SeekRolePermissionsAfterParams) ([]SeekRolePermissionsAfterRow, error) {
+	rows, err := q.db.Query(ctx, seekRolePermissionsAfter,
+		arg.RoleIds,
+		arg.PermissionIds,
+		arg.RoleValues,
+		arg.PermissionValues,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekRolePermissionsAfterRow{}
+	for rows.Next() {
+		var i SeekRolePermissionsAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoleID,
+			&i.PermissionID,
+			&i.CreatedAt,
+			&i.Role,
+			&i.Permission,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seekRolePermissionsBefore = `-- name: SeekRolePermissionsBefore :many
+SELECT rp.id, rp.role_id, rp.permission_id, rp.created_at,
+       json_build_object(
+           'id', r.id,
+           'title_ru', r.title_ru,
+           'title_en', r.title_en,
+           'title_kk', r.title_kk,
+           'value', r.value,
+           'description_ru', r.description_ru,
+           'description_en', r.description_en,
+           'description_kk', r.description_kk,
+           'created_at', r.created_at,
+           'updated_at', r.updated_at,
+           'deleted_at', r.deleted_at
+       ) as role,
+       json_build_object(
+           'id', p.id,
+           'title_ru', p.title_ru,
+           'title_en', p.title_en,
+           'title_kk', p.title_kk,
+           'value', p.value,
+           'description_ru', p.description_ru,
+           'description_en', p.description_en,
+           'description_kk', p.description_kk,
+           'created_at', p.created_at,
+           'updated_at', p.updated_at,
+           'deleted_at', p.deleted_at
+       ) as permission
+FROM role_permissions rp
+INNER JOIN roles r ON rp.role_id = r.id
+INNER JOIN permissions p ON rp.permission_id = p.id
+WHERE
+    r.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+    -- role_ids filter
+    AND (
+        $1::uuid[] IS NULL OR
+        rp.role_id = ANY($1::uuid[])
+    )
+    -- permission_ids filter
+    AND (
+        $2::uuid[] IS NULL OR
+        rp.permission_id = ANY($2::uuid[])
+    )
+    -- role_values filter
+    AND (
+        $3::text[] IS NULL OR
+        r.value = ANY($3::text[])
+    )
+    -- permission_values filter
+    AND (
+        $4::text[] IS NULL OR
+        p.value = ANY($4::text[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (rp.created_at, rp.id) > ($5::timestamp, $6::uuid)
+    )
+ORDER BY rp.created_at ASC, rp.id ASC
+LIMIT $7
+`
+
+type SeekRolePermissionsBeforeParams struct {
+	RoleIds          []pgtype.UUID    `json:"role_ids"`
+	PermissionIds    []pgtype.UUID    `json:"permission_ids"`
+	RoleValues       []string         `json:"role_values"`
+	PermissionValues []string         `json:"permission_values"`
+	CursorCreatedAt  pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID         pgtype.UUID      `json:"cursor_id"`
+	Limit            int32            `json:"limit"`
+}
+
+type SeekRolePermissionsBeforeRow struct {
+	ID           pgtype.UUID      `json:"id"`
+	RoleID       pgtype.UUID      `json:"role_id"`
+	PermissionID pgtype.UUID      `json:"permission_id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	Role         []byte           `json:"role"`
+	Permission   []byte           `json:"permission"`
+}
+
+func (q *Queries) SeekRolePermissionsBefore(ctx context.Context, arg SeekRolePermissionsBeforeParams) ([]SeekRolePermissionsBeforeRow, error) {
+	rows, err := q.db.Query(ctx, seekRolePermissionsBefore,
+		arg.RoleIds,
+		arg.PermissionIds,
+		arg.RoleValues,
+		arg.PermissionValues,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekRolePermissionsBeforeRow{}
+	for rows.Next() {
+		var i SeekRolePermissionsBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoleID,
+			&i.PermissionID,
+			&i.CreatedAt,
+			&i.Role,
+			&i.Permission,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -78,31 +78,34 @@ FROM roles r
 WHERE
     -- show_deleted filter
     (CASE WHEN $1::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND ($2::boolean IS NOT TRUE OR r.deleted_at IS NOT NULL)
     -- search filter
     AND (
-        $2::text IS NULL OR
-        r.title_ru ILIKE '%' || $2 || '%' OR
-        r.title_en ILIKE '%' || $2 || '%' OR
-        r.title_kk ILIKE '%' || $2 || '%' OR
-        r.description_ru ILIKE '%' || $2 || '%' OR
-        r.description_en ILIKE '%' || $2 || '%' OR
-        r.description_kk ILIKE '%' || $2 || '%' OR
-        r.value ILIKE '%' || $2 || '%'
+        $3::text IS NULL OR
+        r.title_ru ILIKE '%' || $3 || '%' OR
+        r.title_en ILIKE '%' || $3 || '%' OR
+        r.title_kk ILIKE '%' || $3 || '%' OR
+        r.description_ru ILIKE '%' || $3 || '%' OR
+        r.description_en ILIKE '%' || $3 || '%' OR
+        r.description_kk ILIKE '%' || $3 || '%' OR
+        r.value ILIKE '%' || $3 || '%'
     )
     -- values filter
     AND (
-        $3::text[] IS NULL OR
-        r.value = ANY($3::text[])
+        $4::text[] IS NULL OR
+        r.value = ANY($4::text[])
     )
     -- ids filter
     AND (
-        $4::uuid[] IS NULL OR
-        r.id = ANY($4::uuid[])
+        $5::uuid[] IS NULL OR
+        r.id = ANY($5::uuid[])
     )
 `
 
 type CountAllRolesParams struct {
 	ShowDeleted pgtype.Bool   `json:"show_deleted"`
+	OnlyDeleted pgtype.Bool   `json:"only_deleted"`
 	Search      pgtype.Text   `json:"search"`
 	Values      []string      `json:"values"`
 	Ids         []pgtype.UUID `json:"ids"`
@@ -111,6 +114,7 @@ type CountAllRolesParams struct {
 func (q *Queries) CountAllRoles(ctx context.Context, arg CountAllRolesParams) (int64, error) {
 	row := q.db.QueryRow(ctx, countAllRoles,
 		arg.ShowDeleted,
+		arg.OnlyDeleted,
 		arg.Search,
 		arg.Values,
 		arg.Ids,
@@ -517,6 +521,159 @@ func (q *Queries) ListAllRoles(ctx context.Context, arg ListAllRolesParams) ([]L
 	return items, nil
 }
 
+const listRolesForExport = `-- name: ListRolesForExport :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM roles
+WHERE deleted_at IS NULL
+ORDER BY value
+`
+
+// Все неудаленные записи для экспорта, по value
+func (q *Queries) ListRolesForExport(ctx context.Context) ([]Role, error) {
+	rows, err := q.db.Query(ctx, listRolesForExport)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Role{}
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockExpiredDeletedRoles = `-- name: LockExpiredDeletedRoles :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM roles
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < now() - $1::interval
+ORDER BY deleted_at
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type LockExpiredDeletedRolesParams struct {
+	Retention pgtype.Interval `json:"retention"`
+	Limit     int32           `json:"limit"`
+}
+
+// Мягко удаленные записи старше retention для очистки корзины, самые старые первыми
+// Строки, заблокированные другой транзакцией (например, восстановлением), пропускаются
+func (q *Queries) LockExpiredDeletedRoles(ctx context.Context, arg LockExpiredDeletedRolesParams) ([]Role, error) {
+	rows, err := q.db.Query(ctx, lockExpiredDeletedRoles, arg.Retention, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Role{}
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockRoleById = `-- name: LockRoleById :one
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM roles
+WHERE id = $1
+FOR UPDATE
+`
+
+// Блокирует строку (в том числе мягко удаленную) до конца транзакции
+func (q *Queries) LockRoleById(ctx context.Context, id pgtype.UUID) (Role, error) {
+	row := q.db.QueryRow(ctx, lockRoleById, id)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const lockRolesByValues = `-- name: LockRolesByValues :many
+SELECT id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at FROM roles
+WHERE value = ANY($1::text[])
+ORDER BY value
+FOR UPDATE
+`
+
+// Блокирует строки с указанными value (в том числе мягко удаленные) до конца транзакции
+func (q *Queries) LockRolesByValues(ctx context.Context, values []string) ([]Role, error) {
+	rows, err := q.db.Query(ctx, lockRolesByValues, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Role{}
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const paginateAllRoles = `-- name: PaginateAllRoles :many
 SELECT r.id, r.title_ru, r.title_en, r.title_kk, r.description_ru, r.description_kk, r.description_en, r.value, r.created_at, r.updated_at, r.deleted_at,
        COALESCE(
@@ -542,43 +699,46 @@ LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
 WHERE
     -- show_deleted filter
     (CASE WHEN $1::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND ($2::boolean IS NOT TRUE OR r.deleted_at IS NOT NULL)
     -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
     AND (
-        $2::text IS NULL OR
-        r.title_ru ILIKE '%' || $2 || '%' OR
-        r.title_en ILIKE '%' || $2 || '%' OR
-        r.title_kk ILIKE '%' || $2 || '%' OR
-        r.description_ru ILIKE '%' || $2 || '%' OR
-        r.description_en ILIKE '%' || $2 || '%' OR
-        r.description_kk ILIKE '%' || $2 || '%' OR
-        r.value ILIKE '%' || $2 || '%'
+        $3::text IS NULL OR
+        r.title_ru ILIKE '%' || $3 || '%' OR
+        r.title_en ILIKE '%' || $3 || '%' OR
+        r.title_kk ILIKE '%' || $3 || '%' OR
+        r.description_ru ILIKE '%' || $3 || '%' OR
+        r.description_en ILIKE '%' || $3 || '%' OR
+        r.description_kk ILIKE '%' || $3 || '%' OR
+        r.value ILIKE '%' || $3 || '%'
     )
     -- values filter
     AND (
-        $3::text[] IS NULL OR
-        r.value = ANY($3::text[])
+        $4::text[] IS NULL OR
+        r.value = ANY($4::text[])
     )
     -- ids filter
     AND (
-        $4::uuid[] IS NULL OR
-        r.id = ANY($4::uuid[])
+        $5::uuid[] IS NULL OR
+        r.id = ANY($5::uuid[])
     )
 GROUP BY r.id
 ORDER BY
-    CASE WHEN $5 = 'created_at' AND $6 = 'ASC' THEN r.created_at END ASC,
-    CASE WHEN $5 = 'created_at' AND $6 = 'DESC' THEN r.created_at END DESC,
-    CASE WHEN $5 = 'updated_at' AND $6 = 'ASC' THEN r.updated_at END ASC,
-    CASE WHEN $5 = 'updated_at' AND $6 = 'DESC' THEN r.updated_at END DESC,
-    CASE WHEN $5 = 'title_ru' AND $6 = 'ASC' THEN r.title_ru END ASC,
-    CASE WHEN $5 = 'title_ru' AND $6 = 'DESC' THEN r.title_ru END DESC,
-    CASE WHEN $5 = 'value' AND $6 = 'ASC' THEN r.value END ASC,
-    CASE WHEN $5 = 'value' AND $6 = 'DESC' THEN r.value END DESC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'ASC' THEN r.created_at END ASC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'DESC' THEN r.created_at END DESC,
+    CASE WHEN $6 = 'updated_at' AND $7 = 'ASC' THEN r.updated_at END ASC,
+    CASE WHEN $6 = 'updated_at' AND $7 = 'DESC' THEN r.updated_at END DESC,
+    CASE WHEN $6 = 'title_ru' AND $7 = 'ASC' THEN r.title_ru END ASC,
+    CASE WHEN $6 = 'title_ru' AND $7 = 'DESC' THEN r.title_ru END DESC,
+    CASE WHEN $6 = 'value' AND $7 = 'ASC' THEN r.value END ASC,
+    CASE WHEN $6 = 'value' AND $7 = 'DESC' THEN r.value END DESC,
     r.created_at DESC
-LIMIT $8 OFFSET $7
+LIMIT $9 OFFSET $8
 `
 
 type PaginateAllRolesParams struct {
 	ShowDeleted pgtype.Bool   `json:"show_deleted"`
+	OnlyDeleted pgtype.Bool   `json:"only_deleted"`
 	Search      pgtype.Text   `json:"search"`
 	Values      []string      `json:"values"`
 	Ids         []pgtype.UUID `json:"ids"`
@@ -606,6 +766,7 @@ type PaginateAllRolesRow struct {
 func (q *Queries) PaginateAllRoles(ctx context.Context, arg PaginateAllRolesParams) ([]PaginateAllRolesRow, error) {
 	rows, err := q.db.Query(ctx, paginateAllRoles,
 		arg.ShowDeleted,
+		arg.OnlyDeleted,
 		arg.Search,
 		arg.Values,
 		arg.Ids,
@@ -645,6 +806,281 @@ func (q *Queries) PaginateAllRoles(ctx context.Context, arg PaginateAllRolesPara
 	return items, nil
 }
 
+const restoreRoleById = `-- name: RestoreRoleById :one
+UPDATE roles
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at
+`
+
+func (q *Queries) RestoreRoleById(ctx context.Context, id pgtype.UUID) (Role, error) {
+	row := q.db.QueryRow(ctx, restoreRoleById, id)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const seekRolesAfter = `-- name: SeekRolesAfter :many
+
+SELECT r.id, r.title_ru, r.title_en, r.title_kk, r.description_ru, r.description_kk, r.description_en, r.value, r.created_at, r.updated_at, r.deleted_at,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', p.id,
+                   'title_ru', p.title_ru,
+                   'title_en', p.title_en,
+                   'title_kk', p.title_kk,
+                   'value', p.value,
+                   'description_ru', p.description_ru,
+                   'description_en', p.description_en,
+                   'description_kk', p.description_kk,
+                   'created_at', p.created_at,
+                   'updated_at', p.updated_at,
+                   'deleted_at', p.deleted_at
+               )
+           ) FILTER (WHERE p.id IS NOT NULL), '[]'
+       ) as permissions
+FROM roles r
+LEFT JOIN role_permissions rp ON r.id = rp.role_id
+LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN $1::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        $2::text IS NULL OR
+        r.title_ru ILIKE '%' || $2 || '%' OR
+        r.title_en ILIKE '%' || $2 || '%' OR
+        r.title_kk ILIKE '%' || $2 || '%' OR
+        r.description_ru ILIKE '%' || $2 || '%' OR
+        r.description_en ILIKE '%' || $2 || '%' OR
+        r.description_kk ILIKE '%' || $2 || '%' OR
+        r.value ILIKE '%' || $2 || '%'
+    )
+    -- values filter
+    AND (
+        $3::text[] IS NULL OR
+        r.value = ANY($3::text[])
+    )
+    -- ids filter
+    AND (
+        $4::uuid[] IS NULL OR
+        r.id = ANY($4::uuid[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (r.created_at, r.id) < ($5::timestamp, $6::uuid)
+    )
+GROUP BY r.id
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $7
+`
+
+type SeekRolesAfterParams struct {
+	ShowDeleted     pgtype.Bool      `json:"show_deleted"`
+	Search          pgtype.Text      `json:"search"`
+	Values          []string         `json:"values"`
+	Ids             []pgtype.UUID    `json:"ids"`
+	CursorCreatedAt pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID        pgtype.UUID      `json:"cursor_id"`
+	Limit           int32            `json:"limit"`
+}
+
+type SeekRolesAfterRow struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	Value         string           `json:"value"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
+	Permissions   interface{}      `json:"permissions"`
+}
+
+// ============================================================================
+// KEYSET (CURSOR) PAGINATION
+// ============================================================================
+func (q *Queries) SeekRolesAfter(ctx context.Context, arg SeekRolesAfterParams) ([]SeekRolesAfterRow, error) {
+	rows, err := q.db.Query(ctx, seekRolesAfter,
+		arg.ShowDeleted,
+		arg.Search,
+		arg.Values,
+		arg.Ids,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekRolesAfterRow{}
+	for rows.Next() {
+		var i SeekRolesAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Permissions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seekRolesBefore = `-- name: SeekRolesBefore :many
+SELECT r.id, r.title_ru, r.title_en, r.title_kk, r.description_ru, r.description_kk, r.description_en, r.value, r.created_at, r.updated_at, r.deleted_at,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', p.id,
+                   'title_ru', p.title_ru,
+                   'title_en', p.title_en,
+                   'title_kk', p.title_kk,
+                   'value', p.value,
+                   'description_ru', p.description_ru,
+                   'description_en', p.description_en,
+                   'description_kk', p.description_kk,
+                   'created_at', p.created_at,
+                   'updated_at', p.updated_at,
+                   'deleted_at', p.deleted_at
+               )
+           ) FILTER (WHERE p.id IS NOT NULL), '[]'
+       ) as permissions
+FROM roles r
+LEFT JOIN role_permissions rp ON r.id = rp.role_id
+LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN $1::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        $2::text IS NULL OR
+        r.title_ru ILIKE '%' || $2 || '%' OR
+        r.title_en ILIKE '%' || $2 || '%' OR
+        r.title_kk ILIKE '%' || $2 || '%' OR
+        r.description_ru ILIKE '%' || $2 || '%' OR
+        r.description_en ILIKE '%' || $2 || '%' OR
+        r.description_kk ILIKE '%' || $2 || '%' OR
+        r.value ILIKE '%' || $2 || '%'
+    )
+    -- values filter
+    AND (
+        $3::text[] IS NULL OR
+        r.value = ANY($3::text[])
+    )
+    -- ids filter
+    AND (
+        $4::uuid[] IS NULL OR
+        r.id = ANY($4::uuid[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        $5::timestamp IS NULL OR
+        (r.created_at, r.id) > ($5::timestamp, $6::uuid)
+    )
+GROUP BY r.id
+ORDER BY r.created_at ASC, r.id ASC
+LIMIT $7
+`
+
+type SeekRolesBeforeParams struct {
+	ShowDeleted     pgtype.Bool      `json:"show_deleted"`
+	Search          pgtype.Text      `json:"search"`
+	Values          []string         `json:"values"`
+	Ids             []pgtype.UUID    `json:"ids"`
+	CursorCreatedAt pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID        pgtype.UUID      `json:"cursor_id"`
+	Limit           int32            `json:"limit"`
+}
+
+type SeekRolesBeforeRow struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	Value         string           `json:"value"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
+	Permissions   interface{}      `json:"permissions"`
+}
+
+func (q *Queries) SeekRolesBefore(ctx context.Context, arg SeekRolesBeforeParams) ([]SeekRolesBeforeRow, error) {
+	rows, err := q.db.Query(ctx, seekRolesBefore,
+		arg.ShowDeleted,
+		arg.Search,
+		arg.Values,
+		arg.Ids,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeekRolesBeforeRow{}
+	for rows.Next() {
+		var i SeekRolesBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TitleRu,
+			&i.TitleEn,
+			&i.TitleKk,
+			&i.DescriptionRu,
+			&i.DescriptionKk,
+			&i.DescriptionEn,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Permissions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateRoleById = `-- name: UpdateRoleById :one
 UPDATE roles
 SET title_ru = $2,
@@ -697,3 +1133,60 @@ func (q *Queries) UpdateRoleById(ctx context.Context, arg UpdateRoleByIdParams)
 	)
 	return i, err
 }
+
+const updateRoleByIdIfUnmodified = `-- name: UpdateRoleByIdIfUnmodified :one
+UPDATE roles
+SET title_ru = $2,
+    title_en = $3,
+    title_kk = $4,
+    description_ru = $5,
+    description_en = $6,
+    description_kk = $7,
+    value = $8,
+    updated_at = greatest(now()::timestamp, updated_at + interval '1 microsecond')
+WHERE id = $1 AND deleted_at IS NULL AND updated_at = $9
+RETURNING id, title_ru, title_en, title_kk, description_ru, description_kk, description_en, value, created_at, updated_at, deleted_at
+`
+
+type UpdateRoleByIdIfUnmodifiedParams struct {
+	ID            pgtype.UUID      `json:"id"`
+	TitleRu       string           `json:"title_ru"`
+	TitleEn       pgtype.Text      `json:"title_en"`
+	TitleKk       pgtype.Text      `json:"title_kk"`
+	DescriptionRu string           `json:"description_ru"`
+	DescriptionEn pgtype.Text      `json:"description_en"`
+	DescriptionKk pgtype.Text      `json:"description_kk"`
+	Value         string           `json:"value"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+// Оптимистичная блокировка: строка обновляется, только если updated_at не изменился с момента чтения
+// updated_at строго растет, поэтому ETag меняется даже при двух обновлениях в одну микросекунду
+func (q *Queries) UpdateRoleByIdIfUnmodified(ctx context.Context, arg UpdateRoleByIdIfUnmodifiedParams) (Role, error) {
+	row := q.db.QueryRow(ctx, updateRoleByIdIfUnmodified,
+		arg.ID,
+		arg.TitleRu,
+		arg.TitleEn,
+		arg.TitleKk,
+		arg.DescriptionRu,
+		arg.DescriptionEn,
+		arg.DescriptionKk,
+		arg.Value,
+		arg.UpdatedAt,
+	)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.TitleRu,
+		&i.TitleEn,
+		&i.TitleKk,
+		&i.DescriptionRu,
+		&i.DescriptionKk,
+		&i.DescriptionEn,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
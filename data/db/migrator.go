@@ -0,0 +1,334 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Schema содержит файлы миграций data/db/schema, встроенные в бинарник
+//
+//go:embed schema/*.sql
+var Schema embed.FS
+
+const (
+	// MigrationsTable - таблица с текущей версией схемы (формат совместим с golang-migrate)
+	MigrationsTable = "schema_migrations"
+
+	// migrationsLockKey - ключ pg_advisory_lock, исключающий параллельный запуск миграций
+	migrationsLockKey int64 = 4207193361501254801
+)
+
+// ErrDirty возвращается, если предыдущая миграция была прервана и версию нужно исправить через Force
+var ErrDirty = errors.New("database schema is dirty")
+
+// migrationFileRegexp разбирает имя файла вида {version}_{description}.{up|down}.sql
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_([a-zA-Z0-9_\-]+)\.(up|down)\.sql$`)
+
+// Migration описывает одну версию схемы
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+	HasDown bool
+}
+
+// Migrator применяет встроенные миграции к PostgreSQL
+// Все операции выполняются под pg_advisory_lock, каждая миграция - в отдельной транзакции
+// вместе с обновлением версии, поэтому ошибка в миграции не оставляет схему в промежуточном состоянии
+// Перед запуском миграции целевая версия записывается с dirty = true и остается такой,
+// если миграция завершилась ошибкой или процесс был прерван; снять признак можно через Force
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+}
+
+// NewMigrator создает Migrator со встроенными миграциями из Schema
+func NewMigrator(pool *pgxpool.Pool) (*Migrator, error) {
+	source, err := fs.Sub(Schema, "schema")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := LoadMigrations(source)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{pool: pool, migrations: migrations}, nil
+}
+
+// LoadMigrations читает *.sql файлы из корня source и возвращает миграции, отсортированные по версии
+func LoadMigrations(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint]*Migration)
+	hasUp := make(map[uint]bool)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+		content, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		v := uint(version)
+		name := match[1] + "_" + match[2]
+		m, ok := byVersion[v]
+		if !ok {
+			m = &Migration{Version: v, Name: name}
+			byVersion[v] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", v, m.Name, name)
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+			hasUp[v] = true
+		} else {
+			m.Down = string(content)
+			m.HasDown = true
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for v, m := range byVersion {
+		if !hasUp[v] {
+			return nil, fmt.Errorf("migration %s has no up file", m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Migrations возвращает список известных миграций
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Version возвращает текущую версию схемы (0 - миграции не применялись) и признак dirty
+func (m *Migrator) Version(ctx context.Context) (version uint, dirty bool, err error) {
+	err = m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		version, dirty, err = readVersion(ctx, conn)
+		return err
+	})
+	return version, dirty, err
+}
+
+// Up применяет все еще не примененные миграции
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		pos, err := m.currentPosition(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateTo(ctx, conn, pos, len(m.migrations)-1)
+	})
+}
+
+// Down откатывает steps последних примененных миграций
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		pos, err := m.currentPosition(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateTo(ctx, conn, pos, max(pos-steps, -1))
+	})
+}
+
+// Goto приводит схему к версии version, применяя или откатывая миграции (0 - откатить все)
+func (m *Migrator) Goto(ctx context.Context, version uint) error {
+	target, err := m.position(version)
+	if err != nil {
+		return err
+	}
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		pos, err := m.currentPosition(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateTo(ctx, conn, pos, target)
+	})
+}
+
+// Force записывает версию version без выполнения миграций и снимает признак dirty
+// Используется для ручного восстановления после прерванной миграции
+func (m *Migrator) Force(ctx context.Context, version uint) error {
+	if _, err := m.position(version); err != nil {
+		return err
+	}
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		if err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			return writeVersion(ctx, tx, version, false)
+		}); err != nil {
+			return err
+		}
+		log.Printf("⚠️ Migration version forced to %d", version)
+		return nil
+	})
+}
+
+// migrateTo последовательно переходит от позиции from к позиции to (-1 - до первой миграции)
+func (m *Migrator) migrateTo(ctx context.Context, conn *pgxpool.Conn, from, to int) error {
+	if from == to {
+		log.Println("✅ Database schema is up to date, no migrations to apply")
+		return nil
+	}
+
+	for pos := from + 1; pos <= to; pos++ {
+		migration := m.migrations[pos]
+		if err := apply(ctx, conn, migration.Up, migration.Version); err != nil {
+			return fmt.Errorf("migration %s up failed: %w", migration.Name, err)
+		}
+		log.Printf("⬆️ Migration applied: %s", migration.Name)
+	}
+
+	for pos := from; pos > to; pos-- {
+		migration := m.migrations[pos]
+		if !migration.HasDown {
+			return fmt.Errorf("migration %s has no down file", migration.Name)
+		}
+		var previous uint
+		if pos > 0 {
+			previous = m.migrations[pos-1].Version
+		}
+		if err := apply(ctx, conn, migration.Down, previous); err != nil {
+			return fmt.Errorf("migration %s down failed: %w", migration.Name, err)
+		}
+		log.Printf("⬇️ Migration rolled back: %s", migration.Name)
+	}
+
+	return nil
+}
+
+// currentPosition возвращает индекс текущей версии в списке миграций, отказывая при dirty схеме
+func (m *Migrator) currentPosition(ctx context.Context, conn *pgxpool.Conn) (int, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w: version %d, fix it manually and use force", ErrDirty, version)
+	}
+	return m.position(version)
+}
+
+// position возвращает индекс миграции с версией version (-1 для версии 0)
+func (m *Migrator) position(version uint) (int, error) {
+	if version == 0 {
+		return -1, nil
+	}
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown migration version %d", version)
+}
+
+// withLock выполняет fn на выделенном соединении под pg_advisory_lock
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationsLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Блокировка сессионная: если снять ее не удалось, закрываем соединение, чтобы не вернуть его в пул
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockKey); err != nil {
+			log.Printf("⚠️ Failed to release migration lock: %v", err)
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+MigrationsTable+` (
+		version BIGINT NOT NULL PRIMARY KEY,
+		dirty BOOLEAN NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("failed to create %s table: %w", MigrationsTable, err)
+	}
+
+	return fn(conn)
+}
+
+// apply помечает версию version как dirty, затем выполняет SQL миграции и снимает признак в одной транзакции
+// Если транзакция миграции не зафиксирована, в таблице остается dirty = true
+func apply(ctx context.Context, conn *pgxpool.Conn, sql string, version uint) error {
+	if err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		return writeVersion(ctx, tx, version, true)
+	}); err != nil {
+		return fmt.Errorf("failed to mark schema version dirty: %w", err)
+	}
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql); err != nil {
+			return err
+		}
+		return writeVersion(ctx, tx, version, false)
+	})
+}
+
+// readVersion читает текущую версию схемы
+func readVersion(ctx context.Context, conn *pgxpool.Conn) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRow(ctx, "SELECT version, dirty FROM "+MigrationsTable+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version < 0 {
+		return 0, dirty, nil
+	}
+	return uint(version), dirty, nil
+}
+
+// writeVersion заменяет запись о версии схемы (0 - миграции не применены)
+// Версия 0 с признаком dirty хранится как -1, как в golang-migrate
+func writeVersion(ctx context.Context, tx pgx.Tx, version uint, dirty bool) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM "+MigrationsTable); err != nil {
+		return err
+	}
+	if version == 0 && !dirty {
+		return nil
+	}
+	stored := int64(version)
+	if version == 0 {
+		stored = -1
+	}
+	_, err := tx.Exec(ctx, "INSERT INTO "+MigrationsTable+" (version, dirty) VALUES ($1, $2)", stored, dirty)
+	return err
+}
@@ -0,0 +1,186 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newMigratorTestPool подключается к DATABASE_URL с search_path на отдельную схему, удаляемую после теста
+// Таблица schema_migrations и таблицы миграций создаются в этой схеме и не затрагивают основную
+func newMigratorTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("migrator_test_%d", time.Now().UnixNano())
+	admin, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(admin.Close)
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() { _, _ = admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE") })
+
+	poolConfig, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		t.Fatalf("parse DATABASE_URL: %v", err)
+	}
+	poolConfig.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// tableExists проверяет наличие таблицы в схеме из search_path
+func tableExists(t *testing.T, pool *pgxpool.Pool, table string) bool {
+	t.Helper()
+	var exists bool
+	if err := pool.QueryRow(context.Background(), "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists); err != nil {
+		t.Fatalf("check table %s: %v", table, err)
+	}
+	return exists
+}
+
+// TestMigratorDirtyState проходит по состояниям версии схемы: применение, прерванная миграция, отказ при dirty, Force и откат
+func TestMigratorDirtyState(t *testing.T) {
+	pool := newMigratorTestPool(t)
+	migrations, err := LoadMigrations(fstest.MapFS{
+		"000001_create_items.up.sql":   {Data: []byte("CREATE TABLE items (id INT)")},
+		"000001_create_items.down.sql": {Data: []byte("DROP TABLE items")},
+		"000002_add_name.up.sql":       {Data: []byte("ALTER TABLE items ADD COLUMN name TEXT")},
+		"000002_add_name.down.sql":     {Data: []byte("ALTER TABLE items DROP COLUMN name")},
+		"000003_broken.up.sql":         {Data: []byte("CREATE TABLE tags (id INT); SELECT * FROM missing_table")},
+		"000003_broken.down.sql":       {Data: []byte("DROP TABLE IF EXISTS tags")},
+	})
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+	migrator := &Migrator{pool: pool, migrations: migrations}
+	ctx := context.Background()
+
+	steps := []struct {
+		name        string
+		run         func() error
+		wantErr     bool
+		wantErrIs   error
+		wantVersion uint
+		wantDirty   bool
+		wantTables  map[string]bool
+	}{
+		{
+			name:        "initial",
+			run:         func() error { return nil },
+			wantVersion: 0,
+			wantTables:  map[string]bool{"items": false},
+		},
+		{
+			name:        "goto 2",
+			run:         func() error { return migrator.Goto(ctx, 2) },
+			wantVersion: 2,
+			wantTables:  map[string]bool{"items": true},
+		},
+		{
+			name:        "up fails on broken migration",
+			run:         func() error { return migrator.Up(ctx) },
+			wantErr:     true,
+			wantVersion: 3,
+			wantDirty:   true,
+			wantTables:  map[string]bool{"items": true, "tags": false},
+		},
+		{
+			name:        "up refuses dirty schema",
+			run:         func() error { return migrator.Up(ctx) },
+			wantErrIs:   ErrDirty,
+			wantVersion: 3,
+			wantDirty:   true,
+		},
+		{
+			name:        "down refuses dirty schema",
+			run:         func() error { return migrator.Down(ctx, 1) },
+			wantErrIs:   ErrDirty,
+			wantVersion: 3,
+			wantDirty:   true,
+		},
+		{
+			name:        "goto refuses dirty schema",
+			run:         func() error { return migrator.Goto(ctx, 1) },
+			wantErrIs:   ErrDirty,
+			wantVersion: 3,
+			wantDirty:   true,
+		},
+		{
+			name:        "force unknown version",
+			run:         func() error { return migrator.Force(ctx, 7) },
+			wantErr:     true,
+			wantVersion: 3,
+			wantDirty:   true,
+		},
+		{
+			name:        "force clears dirty flag",
+			run:         func() error { return migrator.Force(ctx, 2) },
+			wantVersion: 2,
+		},
+		{
+			name:        "down 1",
+			run:         func() error { return migrator.Down(ctx, 1) },
+			wantVersion: 1,
+			wantTables:  map[string]bool{"items": true},
+		},
+		{
+			name:        "down past the first migration",
+			run:         func() error { return migrator.Down(ctx, 5) },
+			wantVersion: 0,
+			wantTables:  map[string]bool{"items": false},
+		},
+		{
+			name:        "goto 0 is a no-op",
+			run:         func() error { return migrator.Goto(ctx, 0) },
+			wantVersion: 0,
+		},
+	}
+	for _, step := range steps {
+		err := step.run()
+		switch {
+		case step.wantErrIs != nil:
+			if !errors.Is(err, step.wantErrIs) {
+				t.Fatalf("%s: error = %v, want %v", step.name, err, step.wantErrIs)
+			}
+		case step.wantErr:
+			if err == nil {
+				t.Fatalf("%s: error = nil, want error", step.name)
+			}
+		case err != nil:
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+
+		version, dirty, err := migrator.Version(ctx)
+		if err != nil {
+			t.Fatalf("%s: Version() error = %v", step.name, err)
+		}
+		if version != step.wantVersion || dirty != step.wantDirty {
+			t.Fatalf("%s: Version() = %d, dirty %t, want %d, dirty %t", step.name, version, dirty, step.wantVersion, step.wantDirty)
+		}
+		for table, want := range step.wantTables {
+			if got := tableExists(t, pool, table); got != want {
+				t.Errorf("%s: table %s exists = %t, want %t", step.name, table, got, want)
+			}
+		}
+	}
+}
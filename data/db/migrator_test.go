@@ -0,0 +1,127 @@
+package db
+
+import (
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(content string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(content)} }
+
+	tests := []struct {
+		name    string
+		source  fstest.MapFS
+		want    []Migration
+		wantErr string
+	}{
+		{
+			name: "sorted by version",
+			source: fstest.MapFS{
+				"000010_add_index.up.sql":      file("CREATE INDEX"),
+				"000002_create_roles.up.sql":   file("CREATE TABLE roles"),
+				"000002_create_roles.down.sql": file("DROP TABLE roles"),
+				"000001_init.up.sql":           file("SELECT 1"),
+			},
+			want: []Migration{
+				{Version: 1, Name: "000001_init", Up: "SELECT 1"},
+				{Version: 2, Name: "000002_create_roles", Up: "CREATE TABLE roles", Down: "DROP TABLE roles", HasDown: true},
+				{Version: 10, Name: "000010_add_index", Up: "CREATE INDEX"},
+			},
+		},
+		{
+			name: "other files and directories are ignored",
+			source: fstest.MapFS{
+				"000001_init.up.sql":       file("SELECT 1"),
+				"README.md":                file("docs"),
+				"000002_draft.sql":         file("SELECT 2"),
+				"nested/000003_x.up.sql":   file("SELECT 3"),
+				"000004_bad name.up.sql":   file("SELECT 4"),
+				"000005_init.sideways.sql": file("SELECT 5"),
+			},
+			want: []Migration{{Version: 1, Name: "000001_init", Up: "SELECT 1"}},
+		},
+		{
+			name:   "empty source",
+			source: fstest.MapFS{},
+			want:   []Migration{},
+		},
+		{
+			name: "duplicate version",
+			source: fstest.MapFS{
+				"000001_init.up.sql":  file("SELECT 1"),
+				"000001_other.up.sql": file("SELECT 1"),
+			},
+			wantErr: "duplicate migration version 1",
+		},
+		{
+			name: "same version written differently",
+			source: fstest.MapFS{
+				"000001_init.up.sql": file("SELECT 1"),
+				"1_init.down.sql":    file("SELECT 1"),
+			},
+			wantErr: "duplicate migration version 1",
+		},
+		{
+			name: "missing up file",
+			source: fstest.MapFS{
+				"000001_init.up.sql":    file("SELECT 1"),
+				"000002_roles.down.sql": file("DROP TABLE roles"),
+			},
+			wantErr: "migration 000002_roles has no up file",
+		},
+		{
+			name: "version zero",
+			source: fstest.MapFS{
+				"000000_init.up.sql": file("SELECT 1"),
+			},
+			wantErr: "invalid migration version in 000000_init.up.sql",
+		},
+		{
+			name: "version out of range",
+			source: fstest.MapFS{
+				"99999999999999999999_init.up.sql": file("SELECT 1"),
+			},
+			wantErr: "invalid migration version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadMigrations(tt.source)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadMigrations() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadMigrations() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadMigrations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEmbeddedSchema проверяет, что встроенные миграции загружаются и версии идут без пропусков
+func TestEmbeddedSchema(t *testing.T) {
+	source, err := fs.Sub(Schema, "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrations, err := LoadMigrations(source)
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != uint(i+1) {
+			t.Errorf("migration %s has version %d, want %d", migration.Name, migration.Version, i+1)
+		}
+		if !migration.HasDown {
+			t.Errorf("migration %s has no down file", migration.Name)
+		}
+	}
+}
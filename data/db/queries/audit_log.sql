@@ -0,0 +1,85 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_log (actor_id, actor_email, action, entity_type, entity_id, before, after, request_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: PaginateAuditLogs :many
+SELECT a.*
+FROM audit_log a
+WHERE
+    -- actor_ids filter
+    (
+        sqlc.narg('actor_ids')::uuid[] IS NULL OR
+        a.actor_id = ANY(sqlc.narg('actor_ids')::uuid[])
+    )
+    -- actions filter
+    AND (
+        sqlc.narg('actions')::text[] IS NULL OR
+        a.action = ANY(sqlc.narg('actions')::text[])
+    )
+    -- entity_types filter
+    AND (
+        sqlc.narg('entity_types')::text[] IS NULL OR
+        a.entity_type = ANY(sqlc.narg('entity_types')::text[])
+    )
+    -- entity_ids filter
+    AND (
+        sqlc.narg('entity_ids')::uuid[] IS NULL OR
+        a.entity_id = ANY(sqlc.narg('entity_ids')::uuid[])
+    )
+    -- request_ids filter
+    AND (
+        sqlc.narg('request_ids')::text[] IS NULL OR
+        a.request_id = ANY(sqlc.narg('request_ids')::text[])
+    )
+    -- search filter (actor_email)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        a.actor_email ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- period filter [from, to)
+    AND (sqlc.narg('from')::timestamp IS NULL OR a.created_at >= sqlc.narg('from')::timestamp)
+    AND (sqlc.narg('to')::timestamp IS NULL OR a.created_at < sqlc.narg('to')::timestamp)
+ORDER BY
+    CASE WHEN sqlc.narg('sort_order') = 'ASC' THEN a.created_at END ASC,
+    CASE WHEN sqlc.narg('sort_order') = 'DESC' THEN a.created_at END DESC,
+    a.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*)
+FROM audit_log a
+WHERE
+    -- actor_ids filter
+    (
+        sqlc.narg('actor_ids')::uuid[] IS NULL OR
+        a.actor_id = ANY(sqlc.narg('actor_ids')::uuid[])
+    )
+    -- actions filter
+    AND (
+        sqlc.narg('actions')::text[] IS NULL OR
+        a.action = ANY(sqlc.narg('actions')::text[])
+    )
+    -- entity_types filter
+    AND (
+        sqlc.narg('entity_types')::text[] IS NULL OR
+        a.entity_type = ANY(sqlc.narg('entity_types')::text[])
+    )
+    -- entity_ids filter
+    AND (
+        sqlc.narg('entity_ids')::uuid[] IS NULL OR
+        a.entity_id = ANY(sqlc.narg('entity_ids')::uuid[])
+    )
+    -- request_ids filter
+    AND (
+        sqlc.narg('request_ids')::text[] IS NULL OR
+        a.request_id = ANY(sqlc.narg('request_ids')::text[])
+    )
+    -- search filter (actor_email)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        a.actor_email ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- period filter [from, to)
+    AND (sqlc.narg('from')::timestamp IS NULL OR a.created_at >= sqlc.narg('from')::timestamp)
+    AND (sqlc.narg('to')::timestamp IS NULL OR a.created_at < sqlc.narg('to')::timestamp);
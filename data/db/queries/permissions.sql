@@ -70,6 +70,21 @@ SET title_ru = $2,
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdatePermissionByIdIfUnmodified :one
+-- Оптимистичная блокировка: строка обновляется, только если updated_at не изменился с момента чтения
+-- updated_at строго растет, поэтому ETag меняется даже при двух обновлениях в одну микросекунду
+UPDATE permissions
+SET title_ru = $2,
+    title_en = $3,
+    title_kk = $4,
+    description_ru = $5,
+    description_en = $6,
+    description_kk = $7,
+    value = $8,
+    updated_at = greatest(now()::timestamp, updated_at + interval '1 microsecond')
+WHERE id = $1 AND deleted_at IS NULL AND updated_at = $9
+RETURNING *;
+
 -- name: DeletePermissionById :one
 UPDATE permissions
 SET deleted_at = now(),
@@ -77,10 +92,30 @@ SET deleted_at = now(),
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: RestorePermissionById :one
+UPDATE permissions
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
 -- name: HardDeletePermissionById :exec
 DELETE FROM permissions
 WHERE id = $1;
 
+-- name: LockPermissionById :one
+-- Блокирует строку (в том числе мягко удаленную) до конца транзакции
+SELECT * FROM permissions
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockPermissionsByValues :many
+-- Блокирует строки с указанными value (в том числе мягко удаленные) до конца транзакции
+SELECT * FROM permissions
+WHERE value = ANY(sqlc.arg('values')::text[])
+ORDER BY value
+FOR UPDATE;
+
 -- ============================================================================
 -- BULK OPERATIONS
 -- ============================================================================
@@ -113,10 +148,26 @@ RETURNING *;
 DELETE FROM permissions
 WHERE id = ANY($1::uuid[]);
 
+-- name: LockExpiredDeletedPermissions :many
+-- Мягко удаленные записи старше retention для очистки корзины, самые старые первыми
+-- Строки, заблокированные другой транзакцией (например, восстановлением), пропускаются
+SELECT * FROM permissions
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < now() - sqlc.arg('retention')::interval
+ORDER BY deleted_at
+LIMIT sqlc.arg('limit')
+FOR UPDATE SKIP LOCKED;
+
 -- ============================================================================
 -- LIST AND SEARCH OPERATIONS
 -- ============================================================================
 
+-- name: ListPermissionsForExport :many
+-- Все неудаленные записи для экспорта, по value
+SELECT * FROM permissions
+WHERE deleted_at IS NULL
+ORDER BY value;
+
 -- name: ListAllPermissions :many
 SELECT p.*,
        COALESCE(
@@ -200,6 +251,8 @@ LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
 WHERE
     -- show_deleted filter
     (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND (sqlc.narg('only_deleted')::boolean IS NOT TRUE OR p.deleted_at IS NOT NULL)
     -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
     AND (
         sqlc.narg('search')::text IS NULL OR
@@ -240,6 +293,8 @@ FROM permissions p
 WHERE
     -- show_deleted filter
     (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND (sqlc.narg('only_deleted')::boolean IS NOT TRUE OR p.deleted_at IS NOT NULL)
     -- search filter
     AND (
         sqlc.narg('search')::text IS NULL OR
@@ -262,6 +317,120 @@ WHERE
         p.id = ANY(sqlc.narg('ids')::uuid[])
     );
 
+-- ============================================================================
+-- KEYSET (CURSOR) PAGINATION
+-- ============================================================================
+
+-- name: SeekPermissionsAfter :many
+SELECT p.*,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', r.id,
+                   'title_ru', r.title_ru,
+                   'title_en', r.title_en,
+                   'title_kk', r.title_kk,
+                   'value', r.value,
+                   'description_ru', r.description_ru,
+                   'description_en', r.description_en,
+                   'description_kk', r.description_kk,
+                   'created_at', r.created_at,
+                   'updated_at', r.updated_at,
+                   'deleted_at', r.deleted_at
+               )
+           ) FILTER (WHERE r.id IS NOT NULL), '[]'
+       ) as roles
+FROM permissions p
+LEFT JOIN role_permissions rp ON p.id = rp.permission_id
+LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        p.title_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.title_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.title_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.value ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- values filter
+    AND (
+        sqlc.narg('values')::text[] IS NULL OR
+        p.value = ANY(sqlc.narg('values')::text[])
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        p.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (p.created_at, p.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+GROUP BY p.id
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SeekPermissionsBefore :many
+SELECT p.*,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', r.id,
+                   'title_ru', r.title_ru,
+                   'title_en', r.title_en,
+                   'title_kk', r.title_kk,
+                   'value', r.value,
+                   'description_ru', r.description_ru,
+                   'description_en', r.description_en,
+                   'description_kk', r.description_kk,
+                   'created_at', r.created_at,
+                   'updated_at', r.updated_at,
+                   'deleted_at', r.deleted_at
+               )
+           ) FILTER (WHERE r.id IS NOT NULL), '[]'
+       ) as roles
+FROM permissions p
+LEFT JOIN role_permissions rp ON p.id = rp.permission_id
+LEFT JOIN roles r ON rp.role_id = r.id AND r.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE p.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        p.title_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.title_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.title_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.description_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        p.value ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- values filter
+    AND (
+        sqlc.narg('values')::text[] IS NULL OR
+        p.value = ANY(sqlc.narg('values')::text[])
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        p.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (p.created_at, p.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+GROUP BY p.id
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT sqlc.arg('limit');
+
 -- ============================================================================
 -- LEGACY QUERIES (For backward compatibility)
 -- ============================================================================
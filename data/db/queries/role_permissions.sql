@@ -307,6 +307,134 @@ INNER JOIN role_permissions rp ON r.id = rp.role_id
 WHERE rp.permission_id = $1 AND r.deleted_at IS NULL
 ORDER BY r.created_at DESC;
 
+-- ============================================================================
+-- KEYSET (CURSOR) PAGINATION
+-- ============================================================================
+
+-- name: SeekRolePermissionsAfter :many
+SELECT rp.*,
+       json_build_object(
+           'id', r.id,
+           'title_ru', r.title_ru,
+           'title_en', r.title_en,
+           'title_kk', r.title_kk,
+           'value', r.value,
+           'description_ru', r.description_ru,
+           'description_en', r.description_en,
+           'description_kk', r.description_kk,
+           'created_at', r.created_at,
+           'updated_at', r.updated_at,
+           'deleted_at', r.deleted_at
+       ) as role,
+       json_build_object(
+           'id', p.id,
+           'title_ru', p.title_ru,
+           'title_en', p.title_en,
+           'title_kk', p.title_kk,
+           'value', p.value,
+           'description_ru', p.description_ru,
+           'description_en', p.description_en,
+           'description_kk', p.description_kk,
+           'created_at', p.created_at,
+           'updated_at', p.updated_at,
+           'deleted_at', p.deleted_at
+       ) as permission
+FROM role_permissions rp
+INNER JOIN roles r ON rp.role_id = r.id
+INNER JOIN permissions p ON rp.permission_id = p.id
+WHERE
+    r.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+    -- role_ids filter
+    AND (
+        sqlc.narg('role_ids')::uuid[] IS NULL OR
+        rp.role_id = ANY(sqlc.narg('role_ids')::uuid[])
+    )
+    -- permission_ids filter
+    AND (
+        sqlc.narg('permission_ids')::uuid[] IS NULL OR
+        rp.permission_id = ANY(sqlc.narg('permission_ids')::uuid[])
+    )
+    -- role_values filter
+    AND (
+        sqlc.narg('role_values')::text[] IS NULL OR
+        r.value = ANY(sqlc.narg('role_values')::text[])
+    )
+    -- permission_values filter
+    AND (
+        sqlc.narg('permission_values')::text[] IS NULL OR
+        p.value = ANY(sqlc.narg('permission_values')::text[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (rp.created_at, rp.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY rp.created_at DESC, rp.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SeekRolePermissionsBefore :many
+SELECT rp.*,
+       json_build_object(
+           'id', r.id,
+           'title_ru', r.title_ru,
+           'title_en', r.title_en,
+           'title_kk', r.title_kk,
+           'value', r.value,
+           'description_ru', r.description_ru,
+           'description_en', r.description_en,
+           'description_kk', r.description_kk,
+           'created_at', r.created_at,
+           'updated_at', r.updated_at,
+           'deleted_at', r.deleted_at
+       ) as role,
+       json_build_object(
+           'id', p.id,
+           'title_ru', p.title_ru,
+           'title_en', p.title_en,
+           'title_kk', p.title_kk,
+           'value', p.value,
+           'description_ru', p.description_ru,
+           'description_en', p.description_en,
+           'description_kk', p.description_kk,
+           'created_at', p.created_at,
+           'updated_at', p.updated_at,
+           'deleted_at', p.deleted_at
+       ) as permission
+FROM role_permissions rp
+INNER JOIN roles r ON rp.role_id = r.id
+INNER JOIN permissions p ON rp.permission_id = p.id
+WHERE
+    r.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+    -- role_ids filter
+    AND (
+        sqlc.narg('role_ids')::uuid[] IS NULL OR
+        rp.role_id = ANY(sqlc.narg('role_ids')::uuid[])
+    )
+    -- permission_ids filter
+    AND (
+        sqlc.narg('permission_ids')::uuid[] IS NULL OR
+        rp.permission_id = ANY(sqlc.narg('permission_ids')::uuid[])
+    )
+    -- role_values filter
+    AND (
+        sqlc.narg('role_values')::text[] IS NULL OR
+        r.value = ANY(sqlc.narg('role_values')::text[])
+    )
+    -- permission_values filter
+    AND (
+        sqlc.narg('permission_values')::text[] IS NULL OR
+        p.value = ANY(sqlc.narg('permission_values')::text[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (rp.created_at, rp.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY rp.created_at ASC, rp.id ASC
+LIMIT sqlc.arg('limit');
+
 -- ============================================================================
 -- UTILITY OPERATIONS
 -- ============================================================================
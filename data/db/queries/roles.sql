@@ -70,6 +70,21 @@ SET title_ru = $2,
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdateRoleByIdIfUnmodified :one
+-- Оптимистичная блокировка: строка обновляется, только если updated_at не изменился с момента чтения
+-- updated_at строго растет, поэтому ETag меняется даже при двух обновлениях в одну микросекунду
+UPDATE roles
+SET title_ru = $2,
+    title_en = $3,
+    title_kk = $4,
+    description_ru = $5,
+    description_en = $6,
+    description_kk = $7,
+    value = $8,
+    updated_at = greatest(now()::timestamp, updated_at + interval '1 microsecond')
+WHERE id = $1 AND deleted_at IS NULL AND updated_at = $9
+RETURNING *;
+
 -- name: DeleteRoleById :one
 UPDATE roles
 SET deleted_at = now(),
@@ -77,10 +92,30 @@ SET deleted_at = now(),
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: RestoreRoleById :one
+UPDATE roles
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
 -- name: HardDeleteRoleById :exec
 DELETE FROM roles
 WHERE id = $1;
 
+-- name: LockRoleById :one
+-- Блокирует строку (в том числе мягко удаленную) до конца транзакции
+SELECT * FROM roles
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockRolesByValues :many
+-- Блокирует строки с указанными value (в том числе мягко удаленные) до конца транзакции
+SELECT * FROM roles
+WHERE value = ANY(sqlc.arg('values')::text[])
+ORDER BY value
+FOR UPDATE;
+
 -- ============================================================================
 -- BULK OPERATIONS
 -- ============================================================================
@@ -113,10 +148,26 @@ RETURNING *;
 DELETE FROM roles
 WHERE id = ANY($1::uuid[]);
 
+-- name: LockExpiredDeletedRoles :many
+-- Мягко удаленные записи старше retention для очистки корзины, самые старые первыми
+-- Строки, заблокированные другой транзакцией (например, восстановлением), пропускаются
+SELECT * FROM roles
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < now() - sqlc.arg('retention')::interval
+ORDER BY deleted_at
+LIMIT sqlc.arg('limit')
+FOR UPDATE SKIP LOCKED;
+
 -- ============================================================================
 -- LIST AND SEARCH OPERATIONS
 -- ============================================================================
 
+-- name: ListRolesForExport :many
+-- Все неудаленные записи для экспорта, по value
+SELECT * FROM roles
+WHERE deleted_at IS NULL
+ORDER BY value;
+
 -- name: ListAllRoles :many
 SELECT r.*,
        COALESCE(
@@ -200,6 +251,8 @@ LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
 WHERE
     -- show_deleted filter
     (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND (sqlc.narg('only_deleted')::boolean IS NOT TRUE OR r.deleted_at IS NOT NULL)
     -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
     AND (
         sqlc.narg('search')::text IS NULL OR
@@ -240,6 +293,8 @@ FROM roles r
 WHERE
     -- show_deleted filter
     (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- only_deleted filter (корзина: только мягко удаленные)
+    AND (sqlc.narg('only_deleted')::boolean IS NOT TRUE OR r.deleted_at IS NOT NULL)
     -- search filter
     AND (
         sqlc.narg('search')::text IS NULL OR
@@ -262,6 +317,120 @@ WHERE
         r.id = ANY(sqlc.narg('ids')::uuid[])
     );
 
+-- ============================================================================
+-- KEYSET (CURSOR) PAGINATION
+-- ============================================================================
+
+-- name: SeekRolesAfter :many
+SELECT r.*,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', p.id,
+                   'title_ru', p.title_ru,
+                   'title_en', p.title_en,
+                   'title_kk', p.title_kk,
+                   'value', p.value,
+                   'description_ru', p.description_ru,
+                   'description_en', p.description_en,
+                   'description_kk', p.description_kk,
+                   'created_at', p.created_at,
+                   'updated_at', p.updated_at,
+                   'deleted_at', p.deleted_at
+               )
+           ) FILTER (WHERE p.id IS NOT NULL), '[]'
+       ) as permissions
+FROM roles r
+LEFT JOIN role_permissions rp ON r.id = rp.role_id
+LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        r.title_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.title_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.title_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.value ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- values filter
+    AND (
+        sqlc.narg('values')::text[] IS NULL OR
+        r.value = ANY(sqlc.narg('values')::text[])
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        r.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- keyset cursor: (created_at, id) < (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (r.created_at, r.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+GROUP BY r.id
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SeekRolesBefore :many
+SELECT r.*,
+       COALESCE(
+           json_agg(
+               json_build_object(
+                   'id', p.id,
+                   'title_ru', p.title_ru,
+                   'title_en', p.title_en,
+                   'title_kk', p.title_kk,
+                   'value', p.value,
+                   'description_ru', p.description_ru,
+                   'description_en', p.description_en,
+                   'description_kk', p.description_kk,
+                   'created_at', p.created_at,
+                   'updated_at', p.updated_at,
+                   'deleted_at', p.deleted_at
+               )
+           ) FILTER (WHERE p.id IS NOT NULL), '[]'
+       ) as permissions
+FROM roles r
+LEFT JOIN role_permissions rp ON r.id = rp.role_id
+LEFT JOIN permissions p ON rp.permission_id = p.id AND p.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE r.deleted_at IS NULL END)
+    -- search filter (title_ru, title_en, title_kk, description_ru, description_en, description_kk, value)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        r.title_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.title_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.title_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_ru ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_en ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.description_kk ILIKE '%' || sqlc.narg('search') || '%' OR
+        r.value ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- values filter
+    AND (
+        sqlc.narg('values')::text[] IS NULL OR
+        r.value = ANY(sqlc.narg('values')::text[])
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        r.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- keyset cursor: (created_at, id) > (cursor_created_at, cursor_id)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+        (r.created_at, r.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+GROUP BY r.id
+ORDER BY r.created_at ASC, r.id ASC
+LIMIT sqlc.arg('limit');
+
 -- ============================================================================
 -- LEGACY QUERIES (For backward compatibility)
 -- ============================================================================
@@ -0,0 +1,46 @@
+-- ============================================================================
+-- USER ROLES
+-- ============================================================================
+
+-- name: BulkAssignRolesToUser :many
+INSERT INTO user_roles (id, user_id, role_id)
+SELECT gen_random_uuid(), $1, unnest($2::uuid[])
+ON CONFLICT (user_id, role_id) DO NOTHING
+RETURNING *;
+
+-- name: BulkRemoveRolesFromUser :many
+DELETE FROM user_roles
+WHERE user_id = $1 AND role_id = ANY($2::uuid[])
+RETURNING *;
+
+-- name: RemoveAllRolesFromUser :many
+DELETE FROM user_roles
+WHERE user_id = $1
+RETURNING *;
+
+-- name: GetUserRoles :many
+SELECT r.*
+FROM roles r
+INNER JOIN user_roles ur ON r.id = ur.role_id
+WHERE ur.user_id = $1 AND r.deleted_at IS NULL
+ORDER BY r.created_at DESC;
+
+-- name: CheckUserHasPermissionByValue :one
+SELECT EXISTS(
+    SELECT 1
+    FROM user_roles ur
+    INNER JOIN roles r ON ur.role_id = r.id
+    INNER JOIN role_permissions rp ON rp.role_id = r.id
+    INNER JOIN permissions p ON rp.permission_id = p.id
+    WHERE ur.user_id = $1
+      AND p.value = $2
+      AND r.deleted_at IS NULL
+      AND p.deleted_at IS NULL
+) as exists;
+
+-- name: GetUserRoleValues :many
+SELECT r.value
+FROM user_roles ur
+INNER JOIN roles r ON ur.role_id = r.id
+WHERE ur.user_id = $1 AND r.deleted_at IS NULL
+ORDER BY r.value;
@@ -0,0 +1,142 @@
+-- ============================================================================
+-- BASIC CRUD OPERATIONS
+-- ============================================================================
+
+-- name: CreateOneUser :one
+INSERT INTO users (id, email, password_hash, full_name, is_active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateUserById :one
+UPDATE users
+SET full_name = sqlc.narg('full_name'),
+    is_active = sqlc.arg('is_active'),
+    updated_at = now()
+WHERE id = sqlc.arg('id') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateUserCredentialsById :one
+-- Email и пароль меняются отдельно от профиля: это право на вход в чужую учетную запись
+UPDATE users
+SET email = sqlc.arg('email'),
+    password_hash = COALESCE(sqlc.narg('password_hash'), password_hash),
+    updated_at = now()
+WHERE id = sqlc.arg('id') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteUserById :one
+UPDATE users
+SET deleted_at = now(),
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: RestoreUserById :one
+UPDATE users
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: HardDeleteUserById :exec
+DELETE FROM users
+WHERE id = $1;
+
+-- name: LockUserById :one
+-- Блокирует строку (в том числе мягко удаленную) до конца транзакции
+SELECT * FROM users
+WHERE id = $1
+FOR UPDATE;
+
+-- ============================================================================
+-- LIST AND SEARCH OPERATIONS
+-- ============================================================================
+
+-- name: PaginateAllUsers :many
+SELECT u.*,
+       COALESCE(
+           array_agg(r.value ORDER BY r.value) FILTER (WHERE r.id IS NOT NULL), '{}'
+       )::text[] as roles
+FROM users u
+LEFT JOIN user_roles ur ON u.id = ur.user_id
+LEFT JOIN roles r ON ur.role_id = r.id AND r.deleted_at IS NULL
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE u.deleted_at IS NULL END)
+    -- search filter (email, full_name)
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        u.email ILIKE '%' || sqlc.narg('search') || '%' OR
+        u.full_name ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        u.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- role_values filter: users with any of the roles
+    AND (
+        sqlc.narg('role_values')::text[] IS NULL OR
+        EXISTS (
+            SELECT 1
+            FROM user_roles fur
+            INNER JOIN roles fr ON fur.role_id = fr.id
+            WHERE fur.user_id = u.id
+              AND fr.deleted_at IS NULL
+              AND fr.value = ANY(sqlc.narg('role_values')::text[])
+        )
+    )
+GROUP BY u.id
+ORDER BY
+    CASE WHEN sqlc.narg('sort_by') = 'created_at' AND sqlc.narg('sort_order') = 'ASC' THEN u.created_at END ASC,
+    CASE WHEN sqlc.narg('sort_by') = 'created_at' AND sqlc.narg('sort_order') = 'DESC' THEN u.created_at END DESC,
+    CASE WHEN sqlc.narg('sort_by') = 'updated_at' AND sqlc.narg('sort_order') = 'ASC' THEN u.updated_at END ASC,
+    CASE WHEN sqlc.narg('sort_by') = 'updated_at' AND sqlc.narg('sort_order') = 'DESC' THEN u.updated_at END DESC,
+    CASE WHEN sqlc.narg('sort_by') = 'email' AND sqlc.narg('sort_order') = 'ASC' THEN u.email END ASC,
+    CASE WHEN sqlc.narg('sort_by') = 'email' AND sqlc.narg('sort_order') = 'DESC' THEN u.email END DESC,
+    CASE WHEN sqlc.narg('sort_by') = 'full_name' AND sqlc.narg('sort_order') = 'ASC' THEN u.full_name END ASC,
+    CASE WHEN sqlc.narg('sort_by') = 'full_name' AND sqlc.narg('sort_order') = 'DESC' THEN u.full_name END DESC,
+    u.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAllUsers :one
+SELECT COUNT(*)
+FROM users u
+WHERE
+    -- show_deleted filter
+    (CASE WHEN sqlc.narg('show_deleted')::boolean THEN TRUE ELSE u.deleted_at IS NULL END)
+    -- search filter
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        u.email ILIKE '%' || sqlc.narg('search') || '%' OR
+        u.full_name ILIKE '%' || sqlc.narg('search') || '%'
+    )
+    -- ids filter
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL OR
+        u.id = ANY(sqlc.narg('ids')::uuid[])
+    )
+    -- role_values filter
+    AND (
+        sqlc.narg('role_values')::text[] IS NULL OR
+        EXISTS (
+            SELECT 1
+            FROM user_roles fur
+            INNER JOIN roles fr ON fur.role_id = fr.id
+            WHERE fur.user_id = u.id
+              AND fr.deleted_at IS NULL
+              AND fr.value = ANY(sqlc.narg('role_values')::text[])
+        )
+    );
+
+-- ============================================================================
+-- AUTHENTICATION
+-- ============================================================================
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 AND deleted_at IS NULL;
+
+-- name: GetUserById :one
+SELECT * FROM users
+WHERE id = $1 AND deleted_at IS NULL;
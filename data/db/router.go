@@ -0,0 +1,187 @@
+package db
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// queryNamePrefix - sqlc начинает текст каждого запроса с "-- name: <Имя> :<тип>"
+const queryNamePrefix = "-- name: "
+
+// readQueryPrefixes - запросы с такими именами только читают данные и могут выполняться на реплике
+var readQueryPrefixes = []string{"List", "Paginate", "Count", "Get", "Seek"}
+
+// primaryContextKey - ключ контекста, требующий чтения с основной БД
+type primaryContextKey struct{}
+
+// WithPrimary помечает контекст: все запросы выполняются на основной БД (read your writes)
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+// UsesPrimary сообщает, помечен ли контекст WithPrimary
+func UsesPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryContextKey{}).(bool)
+	return primary
+}
+
+// Router реализует generated.DBTX и распределяет запросы между основной БД и репликой
+// Внутри TxManager все запросы выполняются в транзакции из контекста
+// На реплику уходят запросы sqlc с именами List*, Paginate*, Count*, Get*, Seek*
+// Запись, COPY, batch и транзакции (WithTx) всегда выполняются на основной БД
+// Если реплика не настроена, недоступна или отстает больше maxLag, чтение идет на основную БД
+type Router struct {
+	primary *pgxpool.Pool
+	replica *pgxpool.Pool
+	maxLag  time.Duration
+	healthy atomic.Bool
+}
+
+// NewRouter создает Router, replica может быть nil
+func NewRouter(primary, replica *pgxpool.Pool, maxLag time.Duration) *Router {
+	r := &Router{primary: primary, replica: replica, maxLag: maxLag}
+	r.healthy.Store(replica != nil)
+	return r
+}
+
+// Primary возвращает пул основной БД
+func (r *Router) Primary() *pgxpool.Pool {
+	return r.primary
+}
+
+// ReplicaHealthy сообщает, используется ли реплика для чтения
+func (r *Router) ReplicaHealthy() bool {
+	return r.replica != nil && r.healthy.Load()
+}
+
+func (r *Router) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return r.writer(ctx).Exec(ctx, sql, args...)
+}
+
+func (r *Router) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return r.reader(ctx, sql).Query(ctx, sql, args...)
+}
+
+func (r *Router) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return r.reader(ctx, sql).QueryRow(ctx, sql, args...)
+}
+
+func (r *Router) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return r.writer(ctx).CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+func (r *Router) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return r.writer(ctx).SendBatch(ctx, b)
+}
+
+// writer возвращает транзакцию из контекста или основную БД
+func (r *Router) writer(ctx context.Context) generated.DBTX {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return r.primary
+}
+
+// reader выбирает транзакцию из контекста, реплику или основную БД
+func (r *Router) reader(ctx context.Context, sql string) generated.DBTX {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	if !r.ReplicaHealthy() || UsesPrimary(ctx) || !isReadQuery(sql) {
+		return r.primary
+	}
+	return r.replica
+}
+
+// isReadQuery определяет по имени запроса sqlc, что он только читает данные
+func isReadQuery(sql string) bool {
+	name, ok := strings.CutPrefix(sql, queryNamePrefix)
+	if !ok {
+		return false
+	}
+	for _, prefix := range readQueryPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckReplica проверяет доступность реплики и ее отставание, результат определяет маршрутизацию чтения
+func (r *Router) CheckReplica(ctx context.Context) error {
+	if r.replica == nil {
+		return nil
+	}
+
+	err := r.checkReplica(ctx)
+	healthy := err == nil
+	if r.healthy.Swap(healthy) != healthy {
+		if healthy {
+			log.Println("✅ Read replica is healthy, routing reads to replica")
+		} else {
+			log.Printf("⚠️ Read replica is unhealthy, routing reads to primary: %v", err)
+		}
+	}
+	return err
+}
+
+func (r *Router) checkReplica(ctx context.Context) error {
+	// На основной БД функции восстановления возвращают NULL, отставание считается нулевым
+	// Если реплика применила весь полученный WAL, она не отстает: без новых записей на основной БД
+	// время последней примененной транзакции стареет, но это не отставание
+	var lag pgtype.Interval
+	if err := r.replica.QueryRow(ctx, `SELECT CASE
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN interval '0'
+		ELSE now() - pg_last_xact_replay_timestamp()
+	END`).Scan(&lag); err != nil {
+		return err
+	}
+	if r.maxLag > 0 && lag.Valid {
+		current := time.Duration(lag.Microseconds)*time.Microsecond +
+			time.Duration(lag.Days)*24*time.Hour
+		if current > r.maxLag {
+			return &ReplicaLagError{Lag: current, MaxLag: r.maxLag}
+		}
+	}
+	return nil
+}
+
+// WatchReplica проверяет реплику с интервалом interval до отмены ctx
+func (r *Router) WatchReplica(ctx context.Context, interval time.Duration) {
+	if r.replica == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, interval)
+			_ = r.CheckReplica(checkCtx)
+			cancel()
+		}
+	}
+}
+
+// ReplicaLagError - реплика отстает от основной БД больше допустимого
+type ReplicaLagError struct {
+	Lag    time.Duration
+	MaxLag time.Duration
+}
+
+func (e *ReplicaLagError) Error() string {
+	return "replica lag " + e.Lag.String() + " exceeds " + e.MaxLag.String()
+}
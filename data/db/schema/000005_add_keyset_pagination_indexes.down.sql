@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_role_permissions_created_at_id;
+DROP INDEX IF EXISTS idx_permissions_created_at_id;
+DROP INDEX IF EXISTS idx_roles_created_at_id;
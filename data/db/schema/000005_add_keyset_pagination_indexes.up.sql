@@ -0,0 +1,3 @@
+CREATE INDEX idx_roles_created_at_id ON roles(created_at DESC, id DESC);
+CREATE INDEX idx_permissions_created_at_id ON permissions(created_at DESC, id DESC);
+CREATE INDEX idx_role_permissions_created_at_id ON role_permissions(created_at DESC, id DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS users CASCADE;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE users(
+                      id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                      email VARCHAR(255) UNIQUE NOT NULL,
+                      password_hash VARCHAR(255) NOT NULL,
+                      full_name VARCHAR(255),
+                      is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                      created_at TIMESTAMP NOT NULL DEFAULT now(),
+                      updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                      deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_users_email ON users(email);
@@ -0,0 +1,21 @@
+-- Оповещение экземпляров приложения об изменении прав ролей (сброс кэша разрешений)
+-- Канал слушает authorization.PermissionCache; полезная нагрузка - имя измененной таблицы
+CREATE OR REPLACE FUNCTION notify_role_permissions_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('role_permissions_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_role_permissions_notify
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON role_permissions
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_role_permissions_changed();
+
+-- Для ролей и разрешений важны только значение и мягкое удаление
+CREATE TRIGGER trg_roles_notify
+    AFTER UPDATE OF value, deleted_at OR DELETE OR TRUNCATE ON roles
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_role_permissions_changed();
+
+CREATE TRIGGER trg_permissions_notify
+    AFTER UPDATE OF value, deleted_at OR DELETE OR TRUNCATE ON permissions
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_role_permissions_changed();
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_users_truncate_notify ON users;
+DROP TRIGGER IF EXISTS trg_users_notify ON users;
+DROP TABLE IF EXISTS user_roles CASCADE;
+DROP FUNCTION IF EXISTS notify_users_changed();
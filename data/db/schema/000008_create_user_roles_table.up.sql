@@ -0,0 +1,54 @@
+CREATE TABLE user_roles(
+                           id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                           user_id UUID NOT NULL,
+                           role_id UUID NOT NULL,
+                           created_at TIMESTAMP NOT NULL DEFAULT now(),
+                           CONSTRAINT fk_user_roles_user
+                               FOREIGN KEY (user_id)
+                                   REFERENCES users(id)
+                                   ON DELETE CASCADE,
+                           CONSTRAINT fk_user_roles_role
+                               FOREIGN KEY (role_id)
+                                   REFERENCES roles(id)
+                                   ON DELETE CASCADE,
+                           CONSTRAINT uq_user_role UNIQUE (user_id, role_id)
+);
+
+CREATE INDEX idx_user_roles_user_id ON user_roles(user_id);
+CREATE INDEX idx_user_roles_role_id ON user_roles(role_id);
+
+-- Оповещение экземпляров приложения об изменении пользователя: его ролей, email, активности или удаления
+-- Полезная нагрузка - ID пользователя, пустая строка (TRUNCATE) - изменены все пользователи
+-- TG_ARGV[0] - колонка с ID пользователя в таблице триггера
+CREATE OR REPLACE FUNCTION notify_users_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_LEVEL = 'STATEMENT' THEN
+        PERFORM pg_notify('users_changed', '');
+        RETURN NULL;
+    END IF;
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM pg_notify('users_changed', to_jsonb(OLD) ->> TG_ARGV[0]);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM pg_notify('users_changed', to_jsonb(NEW) ->> TG_ARGV[0]);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_user_roles_notify
+    AFTER INSERT OR UPDATE OR DELETE ON user_roles
+    FOR EACH ROW EXECUTE FUNCTION notify_users_changed('user_id');
+
+CREATE TRIGGER trg_user_roles_truncate_notify
+    AFTER TRUNCATE ON user_roles
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_users_changed();
+
+-- Для пользователей важны только email, активность и мягкое удаление
+CREATE TRIGGER trg_users_notify
+    AFTER UPDATE OF email, is_active, deleted_at OR DELETE ON users
+    FOR EACH ROW EXECUTE FUNCTION notify_users_changed('id');
+
+CREATE TRIGGER trg_users_truncate_notify
+    AFTER TRUNCATE ON users
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_users_changed();
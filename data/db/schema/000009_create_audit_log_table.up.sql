@@ -0,0 +1,19 @@
+-- Журнал изменений RBAC: кто, что и когда изменил, состояние до и после
+-- Внешних ключей нет: записи журнала переживают удаление пользователей и сущностей
+CREATE TABLE audit_log(
+                          id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                          actor_id UUID,
+                          actor_email VARCHAR(255),
+                          action VARCHAR(50) NOT NULL,
+                          entity_type VARCHAR(50) NOT NULL,
+                          entity_id UUID,
+                          before JSONB,
+                          after JSONB,
+                          request_id VARCHAR(255),
+                          created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_audit_log_created_at_id ON audit_log(created_at DESC, id DESC);
+CREATE INDEX idx_audit_log_entity ON audit_log(entity_type, entity_id);
+CREATE INDEX idx_audit_log_actor_id ON audit_log(actor_id);
+CREATE INDEX idx_audit_log_request_id ON audit_log(request_id);
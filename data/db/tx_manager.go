@@ -0,0 +1,161 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Коды ошибок PostgreSQL, после которых транзакцию можно повторить целиком
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// retryBaseDelay - задержка перед первым повтором, далее удваивается (со случайным разбросом)
+const retryBaseDelay = 10 * time.Millisecond
+
+// txContextKey - ключ контекста с текущей транзакцией
+type txContextKey struct{}
+
+// txState - транзакция (или точка сохранения) в контексте и отложенные до фиксации действия
+type txState struct {
+	tx pgx.Tx
+
+	mu          sync.Mutex
+	afterCommit []func()
+}
+
+func (s *txState) addAfterCommit(fns ...func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.afterCommit = append(s.afterCommit, fns...)
+}
+
+// TxOptions - параметры транзакции
+type TxOptions struct {
+	IsoLevel pgx.TxIsoLevel
+	ReadOnly bool
+}
+
+// TxManager выполняет функцию в транзакции (unit of work)
+// Транзакция передается через context: Router и все репозитории поверх generated.Queries подхватывают ее сами
+// Вложенный вызов создает точку сохранения (SAVEPOINT) внутри внешней транзакции
+// Транзакция, прерванная ошибкой сериализации или взаимоблокировкой, повторяется до maxRetries раз
+type TxManager struct {
+	pool       *pgxpool.Pool
+	defaults   TxOptions
+	maxRetries int
+}
+
+// NewTxManager создает TxManager с параметрами транзакций по умолчанию
+func NewTxManager(pool *pgxpool.Pool, defaults TxOptions, maxRetries int) *TxManager {
+	return &TxManager{pool: pool, defaults: defaults, maxRetries: maxRetries}
+}
+
+// Do выполняет fn в транзакции с параметрами по умолчанию
+// fn должна передавать полученный ctx во все вызовы репозиториев
+func (m *TxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.DoWithOptions(ctx, m.defaults, fn)
+}
+
+// DoWithOptions выполняет fn в транзакции с заданными параметрами
+// Во вложенном вызове параметры не применяются: точка сохранения наследует уровень изоляции внешней транзакции
+// fn может быть вызвана повторно, поэтому не должна иметь побочных эффектов вне БД (см. AfterCommit)
+func (m *TxManager) DoWithOptions(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	if parent, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return m.savepoint(ctx, parent, fn)
+	}
+
+	txOptions := pgx.TxOptions{IsoLevel: opts.IsoLevel}
+	if opts.ReadOnly {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+
+	for attempt := 0; ; attempt++ {
+		state := &txState{}
+		err := pgx.BeginTxFunc(ctx, m.pool, txOptions, func(tx pgx.Tx) error {
+			state.tx = tx
+			return fn(context.WithValue(ctx, txContextKey{}, state))
+		})
+		if err == nil {
+			for _, action := range state.afterCommit {
+				action()
+			}
+			return nil
+		}
+
+		if attempt >= m.maxRetries || !IsRetryableTxError(err) {
+			return err
+		}
+		if waitErr := sleepBeforeRetry(ctx, attempt); waitErr != nil {
+			return err
+		}
+	}
+}
+
+// savepoint выполняет fn в точке сохранения внешней транзакции
+// Отложенные действия передаются внешней транзакции, только если точка сохранения не откатилась
+func (m *TxManager) savepoint(ctx context.Context, parent *txState, fn func(ctx context.Context) error) error {
+	state := &txState{}
+	err := pgx.BeginFunc(ctx, parent.tx, func(tx pgx.Tx) error {
+		state.tx = tx
+		return fn(context.WithValue(ctx, txContextKey{}, state))
+	})
+	if err != nil {
+		return err
+	}
+	parent.addAfterCommit(state.afterCommit...)
+	return nil
+}
+
+// TxFromContext возвращает транзакцию, открытую TxManager
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	state, ok := ctx.Value(txContextKey{}).(*txState)
+	if !ok {
+		return nil, false
+	}
+	return state.tx, true
+}
+
+// AfterCommit откладывает fn до фиксации внешней транзакции; вне транзакции fn выполняется сразу
+// При откате транзакции fn не вызывается
+func AfterCommit(ctx context.Context, fn func()) {
+	state, ok := ctx.Value(txContextKey{}).(*txState)
+	if !ok {
+		fn()
+		return
+	}
+	state.addAfterCommit(fn)
+}
+
+// IsRetryableTxError сообщает, что транзакция прервана ошибкой сериализации или взаимоблокировкой
+func IsRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+}
+
+// sleepBeforeRetry ждет перед повтором с экспоненциальной задержкой и разбросом
+func sleepBeforeRetry(ctx context.Context, attempt int) error {
+	delay := retryBaseDelay << attempt
+	delay += rand.N(delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
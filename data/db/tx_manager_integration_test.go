@@ -0,0 +1,250 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Запуск: DATABASE_URL=postgres://... go test -tags integration ./data/db/
+
+// raiseSerializationFailure прерывает транзакцию настоящей ошибкой PostgreSQL 40001
+const raiseSerializationFailure = `DO $$ BEGIN RAISE EXCEPTION 'conflict' USING ERRCODE = '40001'; END $$`
+
+var errRollback = errors.New("rollback")
+
+// newTestPool подключается к DATABASE_URL и создает таблицу, удаляемую после теста
+func newTestPool(t *testing.T) (*pgxpool.Pool, string) {
+	t.Helper()
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	table := fmt.Sprintf("tx_manager_test_%d", time.Now().UnixNano())
+	if _, err := pool.Exec(ctx, "CREATE TABLE "+table+" (value TEXT NOT NULL)"); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP TABLE "+table) })
+	return pool, table
+}
+
+// values возвращает строки таблицы в порядке вставки
+func values(t *testing.T, pool *pgxpool.Pool, table string) []string {
+	t.Helper()
+	rows, err := pool.Query(context.Background(), "SELECT value FROM "+table+" ORDER BY ctid")
+	if err != nil {
+		t.Fatalf("select: %v", err)
+	}
+	defer rows.Close()
+
+	result := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			t.Fatalf("scan: %v", err)
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
+// insert добавляет строку в транзакции из ctx
+func insert(ctx context.Context, table, value string) error {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		return errors.New("no transaction in context")
+	}
+	_, err := tx.Exec(ctx, "INSERT INTO "+table+" (value) VALUES ($1)", value)
+	return err
+}
+
+func TestTxManagerSavepoint(t *testing.T) {
+	pool, table := newTestPool(t)
+	manager := NewTxManager(pool, TxOptions{}, 0)
+
+	tests := []struct {
+		name            string
+		innerErr        error
+		outerErr        error
+		wantErr         error
+		wantRows        []string
+		wantAfterCommit []string
+	}{
+		{
+			name:            "inner and outer commit",
+			wantRows:        []string{"outer", "inner"},
+			wantAfterCommit: []string{"inner", "outer"},
+		},
+		{
+			name:            "inner rollback keeps outer",
+			innerErr:        errRollback,
+			wantRows:        []string{"outer"},
+			wantAfterCommit: []string{"outer"},
+		},
+		{
+			name:            "outer rollback discards inner",
+			outerErr:        errRollback,
+			wantErr:         errRollback,
+			wantRows:        []string{},
+			wantAfterCommit: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := pool.Exec(context.Background(), "TRUNCATE "+table); err != nil {
+				t.Fatal(err)
+			}
+			afterCommit := []string{}
+
+			err := manager.Do(context.Background(), func(ctx context.Context) error {
+				if err := insert(ctx, table, "outer"); err != nil {
+					return err
+				}
+				innerErr := manager.Do(ctx, func(ctx context.Context) error {
+					if err := insert(ctx, table, "inner"); err != nil {
+						return err
+					}
+					AfterCommit(ctx, func() { afterCommit = append(afterCommit, "inner") })
+					return tt.innerErr
+				})
+				if !errors.Is(innerErr, tt.innerErr) {
+					return fmt.Errorf("inner error = %v, want %v", innerErr, tt.innerErr)
+				}
+				AfterCommit(ctx, func() { afterCommit = append(afterCommit, "outer") })
+				return tt.outerErr
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Do() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := values(t, pool, table); !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", got, tt.wantRows)
+			}
+			if !reflect.DeepEqual(afterCommit, tt.wantAfterCommit) {
+				t.Errorf("after commit = %v, want %v", afterCommit, tt.wantAfterCommit)
+			}
+		})
+	}
+}
+
+func TestTxManagerRetry(t *testing.T) {
+	pool, table := newTestPool(t)
+
+	tests := []struct {
+		name         string
+		maxRetries   int
+		failures     int
+		failSQL      string
+		wantAttempts int
+		wantErrCode  string
+		wantRows     []string
+	}{
+		{
+			name:         "retried until success",
+			maxRetries:   3,
+			failures:     2,
+			failSQL:      raiseSerializationFailure,
+			wantAttempts: 3,
+			wantRows:     []string{"attempt 3"},
+		},
+		{
+			name:         "gives up after max retries",
+			maxRetries:   2,
+			failures:     5,
+			failSQL:      raiseSerializationFailure,
+			wantAttempts: 3,
+			wantErrCode:  pgSerializationFailure,
+			wantRows:     []string{},
+		},
+		{
+			name:         "other errors are not retried",
+			maxRetries:   3,
+			failures:     1,
+			failSQL:      `DO $$ BEGIN RAISE EXCEPTION 'broken' USING ERRCODE = '23505'; END $$`,
+			wantAttempts: 1,
+			wantErrCode:  "23505",
+			wantRows:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := pool.Exec(context.Background(), "TRUNCATE "+table); err != nil {
+				t.Fatal(err)
+			}
+			manager := NewTxManager(pool, TxOptions{}, tt.maxRetries)
+			attempts := 0
+			committed := 0
+
+			err := manager.Do(context.Background(), func(ctx context.Context) error {
+				attempts++
+				AfterCommit(ctx, func() { committed++ })
+				if err := insert(ctx, table, fmt.Sprintf("attempt %d", attempts)); err != nil {
+					return err
+				}
+				if attempts <= tt.failures {
+					tx, _ := TxFromContext(ctx)
+					_, err := tx.Exec(ctx, tt.failSQL)
+					return err
+				}
+				return nil
+			})
+
+			var pgErr *pgconn.PgError
+			switch {
+			case tt.wantErrCode == "" && err != nil:
+				t.Fatalf("Do() error = %v", err)
+			case tt.wantErrCode != "" && (!errors.As(err, &pgErr) || pgErr.Code != tt.wantErrCode):
+				t.Fatalf("Do() error = %v, want code %s", err, tt.wantErrCode)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if wantCommitted := len(tt.wantRows); committed != wantCommitted {
+				t.Errorf("after commit calls = %d, want %d", committed, wantCommitted)
+			}
+			if got := values(t, pool, table); !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTxManagerRetryInsideSavepointIsNotRepeated(t *testing.T) {
+	pool, _ := newTestPool(t)
+	manager := NewTxManager(pool, TxOptions{}, 3)
+	inner := 0
+
+	err := manager.Do(context.Background(), func(ctx context.Context) error {
+		return manager.Do(ctx, func(ctx context.Context) error {
+			inner++
+			tx, _ := TxFromContext(ctx)
+			_, err := tx.Exec(ctx, raiseSerializationFailure)
+			return err
+		})
+	})
+
+	// Повторяется только внешняя транзакция целиком, вместе с вложенным вызовом
+	if !IsRetryableTxError(err) {
+		t.Fatalf("Do() error = %v, want serialization failure", err)
+	}
+	if inner != 4 {
+		t.Errorf("inner calls = %d, want 4 (1 + maxRetries)", inner)
+	}
+}
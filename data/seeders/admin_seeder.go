@@ -0,0 +1,84 @@
+package seeders
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/pkg/auth"
+	"clean_architecture_fiber/shared/db_constants"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AdminOptions - первый администратор, создаваемый сидером (пустой Email - не создавать)
+type AdminOptions struct {
+	Email    string
+	Password string
+}
+
+// minAdminPasswordLength совпадает с ограничением пароля в API (dto.CreateUserDTO)
+const minAdminPasswordLength = 8
+
+// Validate проверяет email и пароль администратора
+func (o AdminOptions) Validate() error {
+	if o.Email == "" {
+		return nil
+	}
+	if !strings.Contains(o.Email, "@") {
+		return fmt.Errorf("admin email %q is invalid", o.Email)
+	}
+	if len(o.Password) < minAdminPasswordLength {
+		return fmt.Errorf("admin password must be at least %d characters", minAdminPasswordLength)
+	}
+	return nil
+}
+
+// seedAdmin создает пользователя opts.Email, если его нет, и назначает ему роль admin
+// Пароль существующего пользователя не меняется, поэтому повторный запуск безопасен
+func (s *seeder) seedAdmin(ctx context.Context, opts AdminOptions) error {
+	email := strings.ToLower(strings.TrimSpace(opts.Email))
+
+	role, err := s.q.GetRoleByValue(ctx, db_constants.AdminRoleValueConstant)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("role %q not found, seed roles first", db_constants.AdminRoleValueConstant)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get role %q: %w", db_constants.AdminRoleValueConstant, err)
+	}
+
+	user, err := s.q.GetUserByEmail(ctx, email)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		passwordHash, err := auth.HashPassword(opts.Password)
+		if err != nil {
+			return err
+		}
+		user, err = s.q.CreateOneUser(ctx, generated.CreateOneUserParams{
+			ID:           newUUID(),
+			Email:        email,
+			PasswordHash: passwordHash,
+			IsActive:     true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create admin user %q: %w", email, err)
+		}
+		s.change("create user %q", email)
+	case err != nil:
+		return fmt.Errorf("failed to get admin user %q: %w", email, err)
+	}
+
+	assigned, err := s.q.BulkAssignRolesToUser(ctx, generated.BulkAssignRolesToUserParams{
+		UserID:  user.ID,
+		Column2: []pgtype.UUID{role.ID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to assign role %q to %q: %w", db_constants.AdminRoleValueConstant, email, err)
+	}
+	if len(assigned) > 0 {
+		s.change("assign role %q to user %q", db_constants.AdminRoleValueConstant, email)
+	}
+	return nil
+}
@@ -0,0 +1,466 @@
+package seeders
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Цели сидирования для SeedOptions.Only
+const (
+	TargetRoles           = "role"
+	TargetPermissions     = "permission"
+	TargetRolePermissions = "role_permission"
+)
+
+// AllTargets - все цели сидирования в порядке выполнения
+var AllTargets = []string{TargetRoles, TargetPermissions, TargetRolePermissions}
+
+// errDryRun откатывает транзакцию пробного запуска
+var errDryRun = errors.New("dry run")
+
+// SeedOptions - параметры запуска сидера
+type SeedOptions struct {
+	// DryRun выполняет сидирование в транзакции и откатывает ее, возвращая план изменений
+	DryRun bool
+	// Only ограничивает сидирование перечисленными целями (пусто - все цели)
+	Only []string
+	// Admin - первый администратор; создается после фикстур независимо от Only
+	Admin AdminOptions
+}
+
+// SeedCounts - итог сидирования одной таблицы
+type SeedCounts struct {
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+// SeedReport - итог сидирования всех таблиц
+type SeedReport struct {
+	Roles           SeedCounts
+	Permissions     SeedCounts
+	RolePermissions SeedCounts
+	// Changes - список примененных (или запланированных при DryRun) изменений
+	Changes []string
+}
+
+// String возвращает краткий отчет для логов
+func (r SeedReport) String() string {
+	format := func(c SeedCounts) string {
+		return fmt.Sprintf("created %d, updated %d, unchanged %d", c.Created, c.Updated, c.Unchanged)
+	}
+	return fmt.Sprintf("roles: %s; permissions: %s; role_permissions: %s",
+		format(r.Roles), format(r.Permissions), format(r.RolePermissions))
+}
+
+// ParseTargets разбирает список целей через запятую (например "role,permission")
+func ParseTargets(value string) ([]string, error) {
+	var targets []string
+	for _, target := range strings.Split(value, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		if !slices.Contains(AllTargets, target) {
+			return nil, fmt.Errorf("unknown seed target %q, expected one of: %s", target, strings.Join(AllTargets, ", "))
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// Seed приводит роли, разрешения и их связи к состоянию из фикстур в одной транзакции
+// Новые записи вставляются через COPY (BulkCreate*), существующие (по value) обновляются только при отличиях
+// Мягко удаленные записи не восстанавливаются и не изменяются, связи с ними пропускаются
+// Сидер только добавляет связи: назначения, отсутствующие в фикстурах, не удаляются
+// Если цель не выбрана в opts.Only, ее записи не изменяются, но существующие используются для связей
+// Если задан opts.Admin.Email, в той же транзакции создается администратор (см. seedAdmin)
+func Seed(ctx context.Context, pool *pgxpool.Pool, fixtures *Fixtures, opts SeedOptions) (SeedReport, error) {
+	if err := opts.Admin.Validate(); err != nil {
+		return SeedReport{}, err
+	}
+
+	targets := opts.Only
+	if len(targets) == 0 {
+		targets = AllTargets
+	}
+
+	var report SeedReport
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		s := &seeder{q: generated.New(tx), report: &report, targets: targets}
+
+		roleIDs, err := s.seedRoles(ctx, fixtures.Roles)
+		if err != nil {
+			return err
+		}
+
+		permissionIDs, err := s.seedPermissions(ctx, fixtures.Permissions)
+		if err != nil {
+			return err
+		}
+
+		if err := s.seedRolePermissions(ctx, fixtures.RolePermissions, roleIDs, permissionIDs); err != nil {
+			return err
+		}
+
+		if opts.Admin.Email != "" {
+			if err := s.seedAdmin(ctx, opts.Admin); err != nil {
+				return err
+			}
+		}
+
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return SeedReport{}, err
+	}
+
+	return report, nil
+}
+
+// seeder хранит состояние одного запуска Seed
+type seeder struct {
+	q       *generated.Queries
+	report  *SeedReport
+	targets []string
+}
+
+// enabled проверяет, выбрана ли цель для изменения
+func (s *seeder) enabled(target string) bool {
+	return slices.Contains(s.targets, target)
+}
+
+// change добавляет запись в список изменений
+func (s *seeder) change(format string, args ...any) {
+	s.report.Changes = append(s.report.Changes, fmt.Sprintf(format, args...))
+}
+
+// seedRoles синхронизирует роли и возвращает ID активных ролей по value
+func (s *seeder) seedRoles(ctx context.Context, fixtures []DictionaryFixture) (map[string]pgtype.UUID, error) {
+	existing, err := s.q.ListAllRoles(ctx, generated.ListAllRolesParams{
+		ShowDeleted: pgtype.Bool{Bool: true, Valid: true}, // мягко удаленные тоже занимают value
+		Values:      dictionaryValues(fixtures),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	ids := make(map[string]pgtype.UUID, len(fixtures))
+	for _, row := range existing {
+		if !row.DeletedAt.Valid {
+			ids[row.Value] = row.ID
+		}
+	}
+	if !s.enabled(TargetRoles) {
+		return ids, nil
+	}
+
+	plan := planDictionary("Role", fixtures, dictionaryRows(existing, func(row generated.ListAllRolesRow) dictionaryRow {
+		return dictionaryRow{
+			ID:      row.ID,
+			Fixture: dictionaryFixtureFromColumns(row.Value, row.TitleRu, row.TitleEn, row.TitleKk, row.DescriptionRu, row.DescriptionEn, row.DescriptionKk),
+			Deleted: row.DeletedAt.Valid,
+		}
+	}))
+	counts := &s.report.Roles
+	counts.Unchanged += plan.Unchanged
+
+	for _, row := range plan.Update {
+		fixture := row.Fixture
+		if _, err := s.q.UpdateRoleById(ctx, generated.UpdateRoleByIdParams{
+			ID:            row.ID,
+			TitleRu:       fixture.Title.Ru,
+			TitleEn:       optionalText(fixture.Title.En),
+			TitleKk:       optionalText(fixture.Title.Kk),
+			DescriptionRu: fixture.Description.Ru,
+			DescriptionEn: optionalText(fixture.Description.En),
+			DescriptionKk: optionalText(fixture.Description.Kk),
+			Value:         fixture.Value,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update role %q: %w", fixture.Value, err)
+		}
+		counts.Updated++
+		s.change("update role %q", fixture.Value)
+	}
+
+	toCreate := make([]generated.BulkCreateRolesParams, 0, len(plan.Create))
+	for _, fixture := range plan.Create {
+		id := newUUID()
+		toCreate = append(toCreate, generated.BulkCreateRolesParams{
+			ID:            id,
+			TitleRu:       fixture.Title.Ru,
+			TitleEn:       optionalText(fixture.Title.En),
+			TitleKk:       optionalText(fixture.Title.Kk),
+			DescriptionRu: fixture.Description.Ru,
+			DescriptionEn: optionalText(fixture.Description.En),
+			DescriptionKk: optionalText(fixture.Description.Kk),
+			Value:         fixture.Value,
+		})
+		ids[fixture.Value] = id
+		s.change("create role %q", fixture.Value)
+	}
+	if len(toCreate) > 0 {
+		if _, err := s.q.BulkCreateRoles(ctx, toCreate); err != nil {
+			return nil, fmt.Errorf("failed to create roles: %w", err)
+		}
+		counts.Created += len(toCreate)
+	}
+
+	return ids, nil
+}
+
+// seedPermissions синхронизирует разрешения и возвращает ID активных разрешений по value
+func (s *seeder) seedPermissions(ctx context.Context, fixtures []DictionaryFixture) (map[string]pgtype.UUID, error) {
+	existing, err := s.q.ListAllPermissions(ctx, generated.ListAllPermissionsParams{
+		ShowDeleted: pgtype.Bool{Bool: true, Valid: true}, // мягко удаленные тоже занимают value
+		Values:      dictionaryValues(fixtures),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+
+	ids := make(map[string]pgtype.UUID, len(fixtures))
+	for _, row := range existing {
+		if !row.DeletedAt.Valid {
+			ids[row.Value] = row.ID
+		}
+	}
+	if !s.enabled(TargetPermissions) {
+		return ids, nil
+	}
+
+	plan := planDictionary("Permission", fixtures, dictionaryRows(existing, func(row generated.ListAllPermissionsRow) dictionaryRow {
+		return dictionaryRow{
+			ID:      row.ID,
+			Fixture: dictionaryFixtureFromColumns(row.Value, row.TitleRu, row.TitleEn, row.TitleKk, row.DescriptionRu, row.DescriptionEn, row.DescriptionKk),
+			Deleted: row.DeletedAt.Valid,
+		}
+	}))
+	counts := &s.report.Permissions
+	counts.Unchanged += plan.Unchanged
+
+	for _, row := range plan.Update {
+		fixture := row.Fixture
+		if _, err := s.q.UpdatePermissionById(ctx, generated.UpdatePermissionByIdParams{
+			ID:            row.ID,
+			TitleRu:       fixture.Title.Ru,
+			TitleEn:       optionalText(fixture.Title.En),
+			TitleKk:       optionalText(fixture.Title.Kk),
+			DescriptionRu: fixture.Description.Ru,
+			DescriptionEn: optionalText(fixture.Description.En),
+			DescriptionKk: optionalText(fixture.Description.Kk),
+			Value:         fixture.Value,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update permission %q: %w", fixture.Value, err)
+		}
+		counts.Updated++
+		s.change("update permission %q", fixture.Value)
+	}
+
+	toCreate := make([]generated.BulkCreatePermissionsParams, 0, len(plan.Create))
+	for _, fixture := range plan.Create {
+		id := newUUID()
+		toCreate = append(toCreate, generated.BulkCreatePermissionsParams{
+			ID:            id,
+			TitleRu:       fixture.Title.Ru,
+			TitleEn:       optionalText(fixture.Title.En),
+			TitleKk:       optionalText(fixture.Title.Kk),
+			DescriptionRu: fixture.Description.Ru,
+			DescriptionEn: optionalText(fixture.Description.En),
+			DescriptionKk: optionalText(fixture.Description.Kk),
+			Value:         fixture.Value,
+		})
+		ids[fixture.Value] = id
+		s.change("create permission %q", fixture.Value)
+	}
+	if len(toCreate) > 0 {
+		if _, err := s.q.BulkCreatePermissions(ctx, toCreate); err != nil {
+			return nil, fmt.Errorf("failed to create permissions: %w", err)
+		}
+		counts.Created += len(toCreate)
+	}
+
+	return ids, nil
+}
+
+// seedRolePermissions создает недостающие связи роль-разрешение
+func (s *seeder) seedRolePermissions(ctx context.Context, fixtures []RolePermissionFixture, roleIDs, permissionIDs map[string]pgtype.UUID) error {
+	if !s.enabled(TargetRolePermissions) || len(fixtures) == 0 {
+		return nil
+	}
+
+	roleValues := make([]string, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		roleValues = append(roleValues, fixture.Role)
+	}
+
+	existing, err := s.q.ListAllRolePermissions(ctx, generated.ListAllRolePermissionsParams{
+		RoleValues: roleValues,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list role permissions: %w", err)
+	}
+
+	linked := make(map[[2]pgtype.UUID]bool, len(existing))
+	for _, row := range existing {
+		linked[[2]pgtype.UUID{row.RoleID, row.PermissionID}] = true
+	}
+
+	links, unchanged := planRolePermissions(fixtures, roleIDs, permissionIDs, linked)
+	counts := &s.report.RolePermissions
+	counts.Unchanged += unchanged
+
+	toCreate := make([]generated.BulkCreateRolePermissionsParams, 0, len(links))
+	for _, link := range links {
+		toCreate = append(toCreate, generated.BulkCreateRolePermissionsParams{
+			ID:           newUUID(),
+			RoleID:       link.RoleID,
+			PermissionID: link.PermissionID,
+		})
+		s.change("grant permission %q to role %q", link.Permission, link.Role)
+	}
+
+	if len(toCreate) > 0 {
+		if _, err := s.q.BulkCreateRolePermissions(ctx, toCreate); err != nil {
+			return fmt.Errorf("failed to create role permissions: %w", err)
+		}
+		counts.Created += len(toCreate)
+	}
+
+	return nil
+}
+
+// dictionaryRow - существующая роль или разрешение в виде фикстуры для сравнения с желаемым состоянием
+type dictionaryRow struct {
+	ID      pgtype.UUID
+	Fixture DictionaryFixture
+	Deleted bool
+}
+
+// dictionaryPlan - изменения таблицы ролей или разрешений
+type dictionaryPlan struct {
+	Create    []DictionaryFixture
+	Update    []dictionaryRow // ID существующей записи и новое состояние
+	Unchanged int
+}
+
+// dictionaryRows преобразует строки sqlc запроса в dictionaryRow
+func dictionaryRows[Row any](rows []Row, convert func(row Row) dictionaryRow) []dictionaryRow {
+	result := make([]dictionaryRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, convert(row))
+	}
+	return result
+}
+
+// planDictionary сравнивает фикстуры с существующими записями по value
+// Отсутствующие записи создаются, отличающиеся обновляются; мягко удаленные пропускаются и считаются неизмененными
+func planDictionary(kind string, fixtures []DictionaryFixture, existing []dictionaryRow) dictionaryPlan {
+	byValue := make(map[string]dictionaryRow, len(existing))
+	for _, row := range existing {
+		byValue[row.Fixture.Value] = row
+	}
+
+	var plan dictionaryPlan
+	for _, fixture := range fixtures {
+		row, ok := byValue[fixture.Value]
+		switch {
+		case !ok:
+			plan.Create = append(plan.Create, fixture)
+		case row.Deleted:
+			log.Printf("⚠️ %s %q is soft-deleted, skipping", kind, fixture.Value)
+			plan.Unchanged++
+		case row.Fixture == fixture:
+			plan.Unchanged++
+		default:
+			plan.Update = append(plan.Update, dictionaryRow{ID: row.ID, Fixture: fixture})
+		}
+	}
+	return plan
+}
+
+// rolePermissionLink - недостающая связь роль-разрешение
+type rolePermissionLink struct {
+	Role         string
+	Permission   string
+	RoleID       pgtype.UUID
+	PermissionID pgtype.UUID
+}
+
+// planRolePermissions возвращает связи из фикстур, которых нет в linked, и число уже существующих
+// Роли и разрешения без ID (удаленные или не созданные) пропускаются
+func planRolePermissions(fixtures []RolePermissionFixture, roleIDs, permissionIDs map[string]pgtype.UUID, linked map[[2]pgtype.UUID]bool) ([]rolePermissionLink, int) {
+	var links []rolePermissionLink
+	unchanged := 0
+	for _, fixture := range fixtures {
+		roleID, ok := roleIDs[fixture.Role]
+		if !ok {
+			log.Printf("⚠️ Role %q is deleted or not seeded, skipping its permissions", fixture.Role)
+			continue
+		}
+		for _, permissionValue := range fixture.Permissions {
+			permissionID, ok := permissionIDs[permissionValue]
+			if !ok {
+				log.Printf("⚠️ Permission %q is deleted or not seeded, skipping", permissionValue)
+				continue
+			}
+			key := [2]pgtype.UUID{roleID, permissionID}
+			if linked[key] {
+				unchanged++
+				continue
+			}
+			linked[key] = true
+			links = append(links, rolePermissionLink{
+				Role:         fixture.Role,
+				Permission:   permissionValue,
+				RoleID:       roleID,
+				PermissionID: permissionID,
+			})
+		}
+	}
+	return links, unchanged
+}
+
+// dictionaryFixtureFromColumns собирает фикстуру из колонок таблицы для сравнения с желаемым состоянием
+func dictionaryFixtureFromColumns(value, titleRu string, titleEn, titleKk pgtype.Text, descriptionRu string, descriptionEn, descriptionKk pgtype.Text) DictionaryFixture {
+	return DictionaryFixture{
+		Value:       value,
+		Title:       LocalizedText{Ru: titleRu, En: titleEn.String, Kk: titleKk.String},
+		Description: LocalizedText{Ru: descriptionRu, En: descriptionEn.String, Kk: descriptionKk.String},
+	}
+}
+
+// dictionaryValues возвращает value всех фикстур
+func dictionaryValues(fixtures []DictionaryFixture) []string {
+	values := make([]string, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		values = append(values, fixture.Value)
+	}
+	return values
+}
+
+// newUUID генерирует новый UUID v4
+func newUUID() pgtype.UUID {
+	return pgtype.UUID{Bytes: uuid.New(), Valid: true}
+}
+
+// optionalText преобразует строку в pgtype.Text, пустая строка сохраняется как NULL
+func optionalText(value string) pgtype.Text {
+	if value == "" {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: value, Valid: true}
+}
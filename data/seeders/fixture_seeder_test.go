@@ -0,0 +1,161 @@
+package seeders
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// testFixtures - фикстуры из набора base в памяти: роли admin и user, разрешения read и edit
+func testFixtures(t *testing.T) *Fixtures {
+	t.Helper()
+	fixtures, err := LoadFixtures(fstest.MapFS{
+		"base/roles.yaml": {Data: []byte(`
+roles:
+  - {value: admin, title: {ru: Администратор}, description: {ru: описание admin}}
+  - {value: user, title: {ru: Пользователь}, description: {ru: описание user}}
+`)},
+		"base/permissions.yaml": {Data: []byte(`
+permissions:
+  - {value: read, title: {ru: Чтение}, description: {ru: описание read}}
+  - {value: edit, title: {ru: Редактирование}, description: {ru: описание edit}}
+role_permissions:
+  - {role: admin, permissions: [read, edit]}
+  - {role: user, permissions: [read]}
+`)},
+	}, "")
+	if err != nil {
+		t.Fatalf("LoadFixtures() error = %v", err)
+	}
+	return fixtures
+}
+
+func TestPlanDictionary(t *testing.T) {
+	fixtures := testFixtures(t)
+	admin, user := fixtures.Roles[0], fixtures.Roles[1]
+	renamed := user
+	renamed.Title.En = "User"
+	adminID, userID := newUUID(), newUUID()
+
+	tests := []struct {
+		name     string
+		existing []dictionaryRow
+		want     dictionaryPlan
+	}{
+		{
+			name: "empty table",
+			want: dictionaryPlan{Create: []DictionaryFixture{admin, user}},
+		},
+		{
+			name: "all unchanged",
+			existing: []dictionaryRow{
+				{ID: adminID, Fixture: admin},
+				{ID: userID, Fixture: user},
+			},
+			want: dictionaryPlan{Unchanged: 2},
+		},
+		{
+			name:     "created and updated",
+			existing: []dictionaryRow{{ID: userID, Fixture: renamed}},
+			want: dictionaryPlan{
+				Create: []DictionaryFixture{admin},
+				Update: []dictionaryRow{{ID: userID, Fixture: user}},
+			},
+		},
+		{
+			name: "soft-deleted is skipped",
+			existing: []dictionaryRow{
+				{ID: adminID, Fixture: admin},
+				{ID: userID, Fixture: renamed, Deleted: true},
+			},
+			want: dictionaryPlan{Unchanged: 2},
+		},
+		{
+			name:     "rows without fixtures are kept",
+			existing: []dictionaryRow{{ID: adminID, Fixture: admin}, {ID: newUUID(), Fixture: fixture("guest", "Гость")}},
+			want:     dictionaryPlan{Create: []DictionaryFixture{user}, Unchanged: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planDictionary("Role", fixtures.Roles, tt.existing)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planDictionary() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanRolePermissions(t *testing.T) {
+	fixtures := testFixtures(t)
+	ids := make(map[string]pgtype.UUID)
+	for _, value := range []string{"admin", "user", "read", "edit"} {
+		ids[value] = newUUID()
+	}
+	roleIDs := map[string]pgtype.UUID{"admin": ids["admin"], "user": ids["user"]}
+	permissionIDs := map[string]pgtype.UUID{"read": ids["read"], "edit": ids["edit"]}
+	link := func(role, permission string) rolePermissionLink {
+		return rolePermissionLink{Role: role, Permission: permission, RoleID: ids[role], PermissionID: ids[permission]}
+	}
+	key := func(role, permission string) [2]pgtype.UUID {
+		return [2]pgtype.UUID{ids[role], ids[permission]}
+	}
+
+	tests := []struct {
+		name          string
+		roleIDs       map[string]pgtype.UUID
+		permissionIDs map[string]pgtype.UUID
+		linked        map[[2]pgtype.UUID]bool
+		want          []rolePermissionLink
+		wantUnchanged int
+	}{
+		{
+			name:          "nothing linked",
+			roleIDs:       roleIDs,
+			permissionIDs: permissionIDs,
+			want:          []rolePermissionLink{link("admin", "read"), link("admin", "edit"), link("user", "read")},
+		},
+		{
+			name:          "partly linked",
+			roleIDs:       roleIDs,
+			permissionIDs: permissionIDs,
+			linked:        map[[2]pgtype.UUID]bool{key("admin", "read"): true, key("user", "read"): true},
+			want:          []rolePermissionLink{link("admin", "edit")},
+			wantUnchanged: 2,
+		},
+		{
+			name:          "deleted role and permission are skipped",
+			roleIDs:       map[string]pgtype.UUID{"admin": ids["admin"]},
+			permissionIDs: map[string]pgtype.UUID{"read": ids["read"]},
+			want:          []rolePermissionLink{link("admin", "read")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linked := make(map[[2]pgtype.UUID]bool)
+			for k, v := range tt.linked {
+				linked[k] = v
+			}
+			got, unchanged := planRolePermissions(fixtures.RolePermissions, tt.roleIDs, tt.permissionIDs, linked)
+			if !reflect.DeepEqual(got, tt.want) || unchanged != tt.wantUnchanged {
+				t.Errorf("planRolePermissions() = %+v, %d unchanged, want %+v, %d unchanged", got, unchanged, tt.want, tt.wantUnchanged)
+			}
+		})
+	}
+}
+
+// TestPlanRolePermissionsDuplicates проверяет, что повтор связи в фикстурах создает ее один раз
+func TestPlanRolePermissionsDuplicates(t *testing.T) {
+	roleID, permissionID := newUUID(), newUUID()
+	links, unchanged := planRolePermissions(
+		[]RolePermissionFixture{{Role: "admin", Permissions: []string{"read", "read"}}},
+		map[string]pgtype.UUID{"admin": roleID},
+		map[string]pgtype.UUID{"read": permissionID},
+		map[[2]pgtype.UUID]bool{},
+	)
+	if len(links) != 1 || unchanged != 1 {
+		t.Errorf("planRolePermissions() = %d links, %d unchanged, want 1 link, 1 unchanged", len(links), unchanged)
+	}
+}
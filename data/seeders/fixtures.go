@@ -0,0 +1,229 @@
+package seeders
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultFixtures содержит встроенные наборы фикстур data/seeders/fixtures
+// Набор base применяется всегда, набор с именем окружения (app.env) - поверх него
+//
+//go:embed fixtures
+var DefaultFixtures embed.FS
+
+// BaseFixtureSet - набор фикстур, общий для всех окружений
+const BaseFixtureSet = "base"
+
+// LocalizedText - текст на поддерживаемых языках (ru обязателен)
+type LocalizedText struct {
+	Ru string `yaml:"ru" json:"ru"`
+	En string `yaml:"en" json:"en"`
+	Kk string `yaml:"kk" json:"kk"`
+}
+
+// DictionaryFixture описывает роль или разрешение, уникальный ключ - value
+type DictionaryFixture struct {
+	Value       string        `yaml:"value" json:"value"`
+	Title       LocalizedText `yaml:"title" json:"title"`
+	Description LocalizedText `yaml:"description" json:"description"`
+}
+
+// RolePermissionFixture назначает роли разрешения по их value
+type RolePermissionFixture struct {
+	Role        string   `yaml:"role" json:"role"`
+	Permissions []string `yaml:"permissions" json:"permissions"`
+}
+
+// Fixtures - декларативное описание начальных данных
+type Fixtures struct {
+	Roles           []DictionaryFixture     `yaml:"roles" json:"roles"`
+	Permissions     []DictionaryFixture     `yaml:"permissions" json:"permissions"`
+	RolePermissions []RolePermissionFixture `yaml:"role_permissions" json:"role_permissions"`
+}
+
+// LoadFixtures читает набор base и набор окружения env из source (корень - каталог fixtures)
+// Поддерживаются файлы .yaml, .yml и .json, внутри набора они применяются в алфавитном порядке
+// Записи с тем же value из более позднего файла заменяют предыдущие, назначения разрешений объединяются
+func LoadFixtures(source fs.FS, env string) (*Fixtures, error) {
+	fixtures := &Fixtures{}
+
+	sets := []string{BaseFixtureSet}
+	if env != "" && env != BaseFixtureSet {
+		sets = append(sets, env)
+	}
+
+	for _, set := range sets {
+		entries, err := fs.ReadDir(source, set)
+		if errors.Is(err, fs.ErrNotExist) && set != BaseFixtureSet {
+			continue // у окружения может не быть собственного набора
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture set %q: %w", set, err)
+		}
+
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				names = append(names, entry.Name())
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			file := path.Join(set, name)
+			loaded, err := readFixtureFile(source, file)
+			if err != nil {
+				return nil, err
+			}
+			if loaded != nil {
+				fixtures.merge(loaded)
+			}
+		}
+	}
+
+	if err := fixtures.Validate(); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+// LoadDefaultFixtures читает встроенные фикстуры для окружения env
+func LoadDefaultFixtures(env string) (*Fixtures, error) {
+	source, err := fs.Sub(DefaultFixtures, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+	return LoadFixtures(source, env)
+}
+
+// Validate проверяет обязательные поля и ссылки назначений на описанные роли и разрешения
+func (f *Fixtures) Validate() error {
+	var problems []string
+
+	roles := make(map[string]bool, len(f.Roles))
+	for i, role := range f.Roles {
+		problems = append(problems, validateDictionaryFixture(fmt.Sprintf("roles[%d]", i), role)...)
+		roles[role.Value] = true
+	}
+
+	permissions := make(map[string]bool, len(f.Permissions))
+	for i, permission := range f.Permissions {
+		problems = append(problems, validateDictionaryFixture(fmt.Sprintf("permissions[%d]", i), permission)...)
+		permissions[permission.Value] = true
+	}
+
+	for _, grant := range f.RolePermissions {
+		if !roles[grant.Role] {
+			problems = append(problems, fmt.Sprintf("role_permissions: unknown role %q", grant.Role))
+		}
+		for _, permission := range grant.Permissions {
+			if !permissions[permission] {
+				problems = append(problems, fmt.Sprintf("role_permissions[%s]: unknown permission %q", grant.Role, permission))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid fixtures: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateDictionaryFixture проверяет поля, обязательные в таблицах roles и permissions
+func validateDictionaryFixture(field string, fixture DictionaryFixture) []string {
+	var problems []string
+	if strings.TrimSpace(fixture.Value) == "" {
+		problems = append(problems, field+".value is required")
+	}
+	if strings.TrimSpace(fixture.Title.Ru) == "" {
+		problems = append(problems, field+".title.ru is required")
+	}
+	if strings.TrimSpace(fixture.Description.Ru) == "" {
+		problems = append(problems, field+".description.ru is required")
+	}
+	return problems
+}
+
+// merge накладывает other поверх текущих фикстур
+func (f *Fixtures) merge(other *Fixtures) {
+	f.Roles = mergeDictionaryFixtures(f.Roles, other.Roles)
+	f.Permissions = mergeDictionaryFixtures(f.Permissions, other.Permissions)
+
+	for _, grant := range other.RolePermissions {
+		index := -1
+		for i := range f.RolePermissions {
+			if f.RolePermissions[i].Role == grant.Role {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			f.RolePermissions = append(f.RolePermissions, RolePermissionFixture{Role: grant.Role})
+			index = len(f.RolePermissions) - 1
+		}
+		for _, permission := range grant.Permissions {
+			if !slices.Contains(f.RolePermissions[index].Permissions, permission) {
+				f.RolePermissions[index].Permissions = append(f.RolePermissions[index].Permissions, permission)
+			}
+		}
+	}
+}
+
+// mergeDictionaryFixtures заменяет записи с совпадающим value и добавляет новые, сохраняя порядок
+func mergeDictionaryFixtures(current, next []DictionaryFixture) []DictionaryFixture {
+	for _, fixture := range next {
+		replaced := false
+		for i := range current {
+			if current[i].Value == fixture.Value {
+				current[i] = fixture
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			current = append(current, fixture)
+		}
+	}
+	return current
+}
+
+// readFixtureFile декодирует один файл фикстур, неизвестные поля считаются ошибкой
+// Файлы с другими расширениями пропускаются
+func readFixtureFile(source fs.FS, file string) (*Fixtures, error) {
+	content, err := fs.ReadFile(source, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %s: %w", file, err)
+	}
+
+	var fixtures Fixtures
+	switch strings.ToLower(path.Ext(file)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&fixtures)
+		if errors.Is(err, io.EOF) {
+			err = nil // пустой файл
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&fixtures)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", file, err)
+	}
+	return &fixtures, nil
+}
@@ -0,0 +1,186 @@
+package seeders
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// fixture возвращает запись с обязательными полями, ru-текст заголовка задается title
+func fixture(value, title string) DictionaryFixture {
+	return DictionaryFixture{
+		Value:       value,
+		Title:       LocalizedText{Ru: title},
+		Description: LocalizedText{Ru: "описание " + value},
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	file := func(content string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(content)} }
+	const baseRoles = `
+roles:
+  - value: admin
+    title: {ru: Администратор}
+    description: {ru: описание admin}
+  - value: user
+    title: {ru: Пользователь}
+    description: {ru: описание user}
+`
+	const basePermissions = `{"permissions": [
+  {"value": "read", "title": {"ru": "Чтение"}, "description": {"ru": "описание read"}},
+  {"value": "edit", "title": {"ru": "Редактирование"}, "description": {"ru": "описание edit"}}
+]}`
+	const baseGrants = `
+role_permissions:
+  - role: admin
+    permissions: [read, edit]
+  - role: user
+    permissions: [read]
+`
+
+	tests := []struct {
+		name    string
+		source  fstest.MapFS
+		env     string
+		want    *Fixtures
+		wantErr string
+	}{
+		{
+			name: "base set only",
+			source: fstest.MapFS{
+				"base/roles.yaml":           file(baseRoles),
+				"base/permissions.json":     file(basePermissions),
+				"base/role_permissions.yml": file(baseGrants),
+				"base/README.md":            file("не фикстура"),
+				"dev/roles.yaml":            file("roles:\n  - value: tester\n    title: {ru: Тестировщик}\n    description: {ru: описание tester}\n"),
+				"base/nested/ignored.yaml":  file("roles: [{value: ghost}]"),
+				"production/override.yaml":  file("roles: [{value: prod}]"),
+				"base/zz_empty.yaml":        file(""),
+				"base/zz_unknown_ext.txt":   file("roles: [{value: ghost}]"),
+			},
+			want: &Fixtures{
+				Roles:       []DictionaryFixture{fixture("admin", "Администратор"), fixture("user", "Пользователь")},
+				Permissions: []DictionaryFixture{fixture("read", "Чтение"), fixture("edit", "Редактирование")},
+				RolePermissions: []RolePermissionFixture{
+					{Role: "admin", Permissions: []string{"read", "edit"}},
+					{Role: "user", Permissions: []string{"read"}},
+				},
+			},
+		},
+		{
+			name: "env set overrides by value and merges grants",
+			source: fstest.MapFS{
+				"base/roles.yaml":            file(baseRoles),
+				"base/permissions.json":      file(basePermissions),
+				"base/role_permissions.yaml": file(baseGrants),
+				"dev/roles.yaml": file(`
+roles:
+  - value: user
+    title: {ru: Участник}
+    description: {ru: описание user}
+  - value: tester
+    title: {ru: Тестировщик}
+    description: {ru: описание tester}
+role_permissions:
+  - role: user
+    permissions: [read, edit]
+  - role: tester
+    permissions: [read]
+`),
+			},
+			env: "dev",
+			want: &Fixtures{
+				Roles: []DictionaryFixture{
+					fixture("admin", "Администратор"), fixture("user", "Участник"), fixture("tester", "Тестировщик"),
+				},
+				Permissions: []DictionaryFixture{fixture("read", "Чтение"), fixture("edit", "Редактирование")},
+				RolePermissions: []RolePermissionFixture{
+					{Role: "admin", Permissions: []string{"read", "edit"}},
+					{Role: "user", Permissions: []string{"read", "edit"}},
+					{Role: "tester", Permissions: []string{"read"}},
+				},
+			},
+		},
+		{
+			name: "later file in a set wins",
+			source: fstest.MapFS{
+				"base/a.yaml": file("permissions:\n  - value: read\n    title: {ru: Первое}\n    description: {ru: описание read}\n"),
+				"base/b.yaml": file("permissions:\n  - value: read\n    title: {ru: Второе}\n    description: {ru: описание read}\n"),
+			},
+			want: &Fixtures{Permissions: []DictionaryFixture{fixture("read", "Второе")}},
+		},
+		{
+			name:   "env without its own set",
+			source: fstest.MapFS{"base/permissions.json": file(basePermissions)},
+			env:    "staging",
+			want:   &Fixtures{Permissions: []DictionaryFixture{fixture("read", "Чтение"), fixture("edit", "Редактирование")}},
+		},
+		{
+			name:    "missing base set",
+			source:  fstest.MapFS{"dev/roles.yaml": file(baseRoles)},
+			env:     "dev",
+			wantErr: `failed to read fixture set "base"`,
+		},
+		{
+			name:    "unknown yaml field",
+			source:  fstest.MapFS{"base/roles.yaml": file("roles:\n  - value: admin\n    name: Администратор\n")},
+			wantErr: "failed to parse fixture base/roles.yaml",
+		},
+		{
+			name:    "unknown json field",
+			source:  fstest.MapFS{"base/roles.json": file(`{"groups": []}`)},
+			wantErr: "failed to parse fixture base/roles.json",
+		},
+		{
+			name:    "missing required fields",
+			source:  fstest.MapFS{"base/roles.yaml": file("roles:\n  - value: admin\n")},
+			wantErr: "roles[0].title.ru is required; roles[0].description.ru is required",
+		},
+		{
+			name: "grant references unknown role and permission",
+			source: fstest.MapFS{
+				"base/roles.yaml": file(baseRoles),
+				"base/grants.yaml": file(`
+role_permissions:
+  - role: ghost
+    permissions: []
+  - role: admin
+    permissions: [delete]
+`),
+			},
+			wantErr: `role_permissions: unknown role "ghost"; role_permissions[admin]: unknown permission "delete"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadFixtures(tt.source, tt.env)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadFixtures() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFixtures() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadFixtures() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLoadDefaultFixtures проверяет встроенные фикстуры: base загружается и проходит Validate для любого окружения
+func TestLoadDefaultFixtures(t *testing.T) {
+	for _, env := range []string{"", BaseFixtureSet, "dev", "production"} {
+		fixtures, err := LoadDefaultFixtures(env)
+		if err != nil {
+			t.Fatalf("LoadDefaultFixtures(%q) error = %v", env, err)
+		}
+		if len(fixtures.Roles) == 0 || len(fixtures.Permissions) == 0 || len(fixtures.RolePermissions) == 0 {
+			t.Errorf("LoadDefaultFixtures(%q) = %d roles, %d permissions, %d grants, want all non-empty",
+				env, len(fixtures.Roles), len(fixtures.Permissions), len(fixtures.RolePermissions))
+		}
+	}
+}
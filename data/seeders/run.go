@@ -0,0 +1,58 @@
+package seeders
+
+import (
+	"clean_architecture_fiber/config"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrProductionSeeding возвращается при запуске сидера в production без Force
+var ErrProductionSeeding = errors.New("refusing to seed production database without force")
+
+// RunOptions - параметры Run
+type RunOptions struct {
+	SeedOptions
+	// Env - окружение базы данных (app.env ее конфигурации), по нему проверяется production
+	Env string
+	// FixtureSet - набор фикстур поверх base (по умолчанию Env)
+	FixtureSet string
+	// Force разрешает сидирование в production (config.ProductionEnv)
+	Force bool
+}
+
+// Run загружает встроенные фикстуры набора FixtureSet и применяет их
+// Набор фикстур не влияет на проверку production: она всегда идет по Env
+// Ошибки возвращаются вызывающему коду, процесс не завершается
+func Run(ctx context.Context, pool *pgxpool.Pool, opts RunOptions) (SeedReport, error) {
+	if strings.EqualFold(opts.Env, config.ProductionEnv) && !opts.Force {
+		return SeedReport{}, ErrProductionSeeding
+	}
+
+	fixtureSet := opts.FixtureSet
+	if fixtureSet == "" {
+		fixtureSet = opts.Env
+	}
+	fixtures, err := LoadDefaultFixtures(fixtureSet)
+	if err != nil {
+		return SeedReport{}, fmt.Errorf("failed to load fixtures: %w", err)
+	}
+
+	report, err := Seed(ctx, pool, fixtures, opts.SeedOptions)
+	if err != nil {
+		return SeedReport{}, fmt.Errorf("seeding failed: %w", err)
+	}
+
+	for _, change := range report.Changes {
+		if opts.DryRun {
+			log.Printf("📝 [dry-run] %s", change)
+		} else {
+			log.Printf("🌱 %s", change)
+		}
+	}
+	return report, nil
+}
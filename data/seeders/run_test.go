@@ -0,0 +1,27 @@
+package seeders
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestRunRefusesProduction проверяет, что набор фикстур не обходит проверку production
+// Run завершается до обращения к БД, поэтому pool не нужен
+func TestRunRefusesProduction(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RunOptions
+	}{
+		{name: "production", opts: RunOptions{Env: "production"}},
+		{name: "case-insensitive", opts: RunOptions{Env: "Production"}},
+		{name: "dev fixture set", opts: RunOptions{Env: "production", FixtureSet: "dev"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Run(context.Background(), nil, tt.opts); !errors.Is(err, ErrProductionSeeding) {
+				t.Errorf("Run() error = %v, want %v", err, ErrProductionSeeding)
+			}
+		})
+	}
+}
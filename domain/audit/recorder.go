@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Действия, сохраняемые в audit_log.action
+const (
+	ActionCreate     = "create"
+	ActionUpdate     = "update"
+	ActionDelete     = "delete"
+	ActionRestore    = "restore"
+	ActionHardDelete = "hard_delete"
+	ActionAssign     = "assign"
+	ActionRemove     = "remove"
+	ActionReplace    = "replace"
+)
+
+// Типы сущностей, сохраняемые в audit_log.entity_type
+// Для связей entity_id - ID владельца набора: роли для role_permission, пользователя для user_role
+const (
+	EntityRole           = "role"
+	EntityPermission     = "permission"
+	EntityRolePermission = "role_permission"
+	EntityUser           = "user"
+	EntityUserRole       = "user_role"
+)
+
+// Actions и Entities - допустимые значения фильтров GET /api/v1/audit
+var (
+	Actions  = []string{ActionCreate, ActionUpdate, ActionDelete, ActionRestore, ActionHardDelete, ActionAssign, ActionRemove, ActionReplace}
+	Entities = []string{EntityRole, EntityPermission, EntityRolePermission, EntityUser, EntityUserRole}
+)
+
+// Entry - изменение, которое нужно записать в журнал
+// Before и After сериализуются в JSON; nil означает, что состояния нет (до создания, после удаления)
+type Entry struct {
+	Action   string
+	Entity   string
+	EntityID pgtype.UUID
+	Before   any
+	After    any
+}
+
+// Recorder записывает изменения в audit_log
+// Record вызывается в той же транзакции (TxManager.Do), что и само изменение:
+// если запись в журнал не удалась, изменение откатывается
+type Recorder struct {
+	Repo repositories.AuditLogRepository
+}
+
+func NewRecorder(repo repositories.AuditLogRepository) *Recorder {
+	return &Recorder{Repo: repo}
+}
+
+// Record сохраняет запись журнала
+// Автор берется из auth.Principal, ID запроса - из заголовка X-Request-ID, выставленного middleware requestid
+func (r *Recorder) Record(fiberCtx *fiber.Ctx, ctx context.Context, entry Entry) error {
+	params, err := entryParams(entry)
+	if err != nil {
+		return err
+	}
+	if principal, ok := auth.GetPrincipal(fiberCtx); ok {
+		// Некорректный ID в токене не должен блокировать изменение: автор останется только с email
+		_ = params.ActorID.Scan(principal.UserID)
+		params.ActorEmail = pgtype.Text{String: principal.Email, Valid: principal.Email != ""}
+	}
+	if requestID := fiberCtx.GetRespHeader(fiber.HeaderXRequestID); requestID != "" {
+		params.RequestID = pgtype.Text{String: requestID, Valid: true}
+	}
+
+	_, err = r.Repo.Create(ctx, params)
+	return err
+}
+
+// RecordSystem сохраняет запись об изменении, которое приложение выполнило само, вне HTTP запроса
+// (например, очистка корзины); автор и ID запроса остаются пустыми
+func (r *Recorder) RecordSystem(ctx context.Context, entry Entry) error {
+	params, err := entryParams(entry)
+	if err != nil {
+		return err
+	}
+	_, err = r.Repo.Create(ctx, params)
+	return err
+}
+
+// entryParams сериализует состояния записи в параметры запроса
+func entryParams(entry Entry) (generated.CreateAuditLogParams, error) {
+	before, err := marshal(entry.Before)
+	if err != nil {
+		return generated.CreateAuditLogParams{}, fmt.Errorf("audit: marshal before: %w", err)
+	}
+	after, err := marshal(entry.After)
+	if err != nil {
+		return generated.CreateAuditLogParams{}, fmt.Errorf("audit: marshal after: %w", err)
+	}
+	return generated.CreateAuditLogParams{
+		Action:     entry.Action,
+		EntityType: entry.Entity,
+		EntityID:   entry.EntityID,
+		Before:     before,
+		After:      after,
+	}, nil
+}
+
+// marshal сериализует состояние; nil сохраняется как SQL NULL
+func marshal(state any) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil || string(data) == "null" {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// userSnapshot - состояние пользователя в журнале, без хеша пароля
+type userSnapshot struct {
+	ID        pgtype.UUID      `json:"id"`
+	Email     string           `json:"email"`
+	FullName  pgtype.Text      `json:"full_name"`
+	IsActive  bool             `json:"is_active"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	DeletedAt pgtype.Timestamp `json:"deleted_at"`
+}
+
+// User возвращает состояние пользователя для Entry.Before/After
+func User(user *generated.User) any {
+	if user == nil {
+		return nil
+	}
+	return userSnapshot{
+		ID:        user.ID,
+		Email:     user.Email,
+		FullName:  user.FullName,
+		IsActive:  user.IsActive,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+		DeletedAt: user.DeletedAt,
+	}
+}
+
+// PermissionValues возвращает набор разрешений роли для Entry.Before/After
+func PermissionValues(permissions []generated.Permission) []string {
+	values := make([]string, len(permissions))
+	for i, permission := range permissions {
+		values[i] = permission.Value
+	}
+	return values
+}
+
+// RoleValues возвращает набор ролей пользователя для Entry.Before/After
+func RoleValues(roles []generated.Role) []string {
+	values := make([]string, len(roles))
+	for i, role := range roles {
+		values[i] = role.Value
+	}
+	return values
+}
@@ -0,0 +1,51 @@
+package authorization
+
+import (
+	"clean_architecture_fiber/shared/db_constants"
+	"context"
+	"strings"
+)
+
+// resourceSeparator отделяет ресурс от действия в значении разрешения: "roles.edit"
+const resourceSeparator = "."
+
+// Authorizer проверяет, есть ли у набора ролей разрешение
+// Назначения ролей берутся из PermissionCache, а не запросом к БД на каждую проверку
+type Authorizer struct {
+	Cache *PermissionCache
+}
+
+func NewAuthorizer(cache *PermissionCache) *Authorizer {
+	return &Authorizer{Cache: cache}
+}
+
+// HasPermission сообщает, дает ли хотя бы одна из ролей требуемое разрешение
+// Разрешение считается выданным, если роли назначено любое из Grants(permission)
+func (a *Authorizer) HasPermission(ctx context.Context, roles []string, permission string) (bool, error) {
+	return a.Cache.HasAny(ctx, roles, Grants(permission))
+}
+
+// Grants возвращает значения разрешений, каждое из которых дает permission
+// Действие manage включает все остальные, действие без ресурса действует на все ресурсы:
+//
+//	"roles.edit" -> "roles.edit", "roles.manage", "edit", "manage"
+//	"edit"       -> "edit", "manage"
+func Grants(permission string) []string {
+	resource, action, scoped := strings.Cut(permission, resourceSeparator)
+	if !scoped {
+		action, resource = resource, ""
+	}
+
+	actions := []string{action}
+	if action != db_constants.ManagePermissionPrefixConstant {
+		actions = append(actions, db_constants.ManagePermissionPrefixConstant)
+	}
+
+	grants := make([]string, 0, len(actions)*2)
+	if scoped {
+		for _, a := range actions {
+			grants = append(grants, resource+resourceSeparator+a)
+		}
+	}
+	return append(grants, actions...)
+}
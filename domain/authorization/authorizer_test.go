@@ -0,0 +1,26 @@
+package authorization
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrants(t *testing.T) {
+	tests := []struct {
+		permission string
+		want       []string
+	}{
+		{permission: "roles.edit", want: []string{"roles.edit", "roles.manage", "edit", "manage"}},
+		{permission: "roles.read", want: []string{"roles.read", "roles.manage", "read", "manage"}},
+		{permission: "roles.manage", want: []string{"roles.manage", "manage"}},
+		{permission: "edit", want: []string{"edit", "manage"}},
+		{permission: "manage", want: []string{"manage"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.permission, func(t *testing.T) {
+			if got := Grants(tt.permission); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grants(%q) = %v, want %v", tt.permission, got, tt.want)
+			}
+		})
+	}
+}
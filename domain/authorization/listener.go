@@ -0,0 +1,62 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	listenMinBackoff = time.Second
+	listenMaxBackoff = 30 * time.Second
+)
+
+// listen подписывается на каналы channels и передает каждое уведомление в notify
+// Блокируется до отмены ctx; при потере соединения переподключается с нарастающей паузой
+// и вызывает reset, так как уведомления за время разрыва потеряны
+func listen(ctx context.Context, pool *pgxpool.Pool, name string, channels []string, notify func(*pgconn.Notification), reset func()) {
+	backoff := listenMinBackoff
+	for ctx.Err() == nil {
+		err := listenOnce(ctx, pool, channels, notify, func() { backoff = listenMinBackoff })
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("⚠️ %s listener: %v, reconnecting in %s", name, err, backoff)
+		reset()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, listenMaxBackoff)
+	}
+}
+
+func listenOnce(ctx context.Context, pool *pgxpool.Pool, channels []string, notify func(*pgconn.Notification), connected func()) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+			return fmt.Errorf("failed to listen %s: %w", channel, err)
+		}
+	}
+	connected()
+
+	for {
+		// При ошибке или отмене ctx pgx закрывает соединение, и Release удаляет его из пула
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		notify(notification)
+	}
+}
@@ -0,0 +1,166 @@
+package authorization
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotifyChannel - канал Postgres, в который триггеры миграции 000007 пишут об изменении прав ролей
+const NotifyChannel = "role_permissions_changed"
+
+// loadTimeout ограничивает загрузку из БД, которая не зависит от отмены запроса
+const loadTimeout = 5 * time.Second
+
+// CacheStats - счетчики кэша разрешений с момента запуска
+type CacheStats struct {
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Invalidations uint64 `json:"invalidations"`
+}
+
+// PermissionCache хранит в памяти набор разрешений каждой роли: role value -> permission values
+// Набор загружается целиком из ListAllRolePermissions с основной БД при первом обращении после сброса:
+// реплика может отставать, и устаревший набор остался бы в кэше до следующего сброса
+// Сбрасывается событиями репозиториев (RolePermissionEvents) и уведомлениями NOTIFY от других экземпляров
+type PermissionCache struct {
+	repo repositories.RolePermissionRepository
+
+	mu         sync.RWMutex
+	roles      map[string]map[string]struct{}
+	generation uint64
+
+	// loadMu не дает нескольким запросам одновременно загружать набор после сброса
+	loadMu sync.Mutex
+
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	invalidations atomic.Uint64
+}
+
+func NewPermissionCache(repo repositories.RolePermissionRepository, events *repositories.RolePermissionEvents) *PermissionCache {
+	cache := &PermissionCache{repo: repo}
+	events.Subscribe(cache.Invalidate)
+	return cache
+}
+
+// HasAny сообщает, назначено ли хотя бы одной из ролей хотя бы одно из разрешений
+func (c *PermissionCache) HasAny(ctx context.Context, roles []string, permissions []string) (bool, error) {
+	snapshot, err := c.snapshot(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		granted := snapshot[role]
+		for _, permission := range permissions {
+			if _, ok := granted[permission]; ok {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Invalidate сбрасывает кэш; следующее обращение загрузит набор заново
+func (c *PermissionCache) Invalidate() {
+	c.mu.Lock()
+	c.roles = nil
+	c.generation++
+	c.mu.Unlock()
+	c.invalidations.Add(1)
+}
+
+// Stats возвращает счетчики попаданий, промахов и сбросов
+func (c *PermissionCache) Stats() CacheStats {
+	return CacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
+
+// Listen подписывается на NotifyChannel и сбрасывает кэш при каждом уведомлении
+// Блокируется до отмены ctx; при потере соединения переподключается и сбрасывает кэш
+func (c *PermissionCache) Listen(ctx context.Context, pool *pgxpool.Pool) {
+	listen(ctx, pool, "Permission cache", []string{NotifyChannel}, func(*pgconn.Notification) {
+		c.Invalidate()
+	}, c.Invalidate)
+}
+
+// snapshot возвращает загруженный набор или загружает его
+func (c *PermissionCache) snapshot(ctx context.Context) (map[string]map[string]struct{}, error) {
+	c.mu.RLock()
+	roles := c.roles
+	c.mu.RUnlock()
+	if roles != nil {
+		c.hits.Add(1)
+		return roles, nil
+	}
+	c.misses.Add(1)
+
+	c.loadMu.Lock()
+	defer c.loadMu.Unlock()
+
+	// Набор мог загрузить запрос, ожидавший loadMu раньше
+	c.mu.RLock()
+	roles, generation := c.roles, c.generation
+	c.mu.RUnlock()
+	if roles != nil {
+		return roles, nil
+	}
+
+	roles, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Если кэш сбросили во время загрузки, набор мог устареть: используем его только для этого запроса
+	c.mu.Lock()
+	if c.generation == generation {
+		c.roles = roles
+	}
+	c.mu.Unlock()
+
+	return roles, nil
+}
+
+// load читает набор с основной БД
+// Контекст отвязан от отмены запроса: набор ждут и другие запросы, заблокированные на loadMu
+func (c *PermissionCache) load(ctx context.Context) (map[string]map[string]struct{}, error) {
+	ctx, cancel := context.WithTimeout(db.WithPrimary(context.WithoutCancel(ctx)), loadTimeout)
+	defer cancel()
+
+	rows, err := c.repo.ListAll(ctx, generated.ListAllRolePermissionsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	var value struct {
+		Value string `json:"value"`
+	}
+	roles := make(map[string]map[string]struct{})
+	for _, row := range rows {
+		if err := json.Unmarshal(row.Role, &value); err != nil {
+			return nil, fmt.Errorf("failed to decode role of role permission: %w", err)
+		}
+		role := value.Value
+		if err := json.Unmarshal(row.Permission, &value); err != nil {
+			return nil, fmt.Errorf("failed to decode permission of role permission: %w", err)
+		}
+
+		if roles[role] == nil {
+			roles[role] = make(map[string]struct{})
+		}
+		roles[role][value.Value] = struct{}{}
+	}
+	return roles, nil
+}
@@ -0,0 +1,154 @@
+package authorization
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// UserNotifyChannel - канал Postgres, в который триггеры миграции 000008 пишут ID измененного пользователя
+const UserNotifyChannel = "users_changed"
+
+// rolesTable - полезная нагрузка NotifyChannel от триггера таблицы roles (значение или удаление роли)
+const rolesTable = "roles"
+
+// maxPrincipals - наибольшее число пользователей в кэше; при переполнении кэш очищается целиком
+const maxPrincipals = 10000
+
+// principalEntry - пользователь в кэше вместе с признаком активности
+type principalEntry struct {
+	principal auth.Principal
+	active    bool
+}
+
+// PrincipalCache хранит пользователей запросов: ID пользователя -> email, значения ролей и активность
+// Access токен содержит только ID пользователя, роли и статус читаются с основной БД при первом запросе
+// после сброса, поэтому снятие роли, блокировка и удаление действуют, не дожидаясь истечения токена
+// Записи пользователя сбрасываются событиями UserEvents, весь кэш - событиями RolePermissionEvents
+// (переименование и удаление ролей); другие экземпляры и ручной SQL оповещают через NOTIFY (см. Listen)
+type PrincipalCache struct {
+	users repositories.UserRepository
+
+	mu         sync.RWMutex
+	entries    map[[16]byte]principalEntry
+	generation uint64
+}
+
+func NewPrincipalCache(users repositories.UserRepository, userEvents *repositories.UserEvents, events *repositories.RolePermissionEvents) *PrincipalCache {
+	cache := &PrincipalCache{users: users, entries: make(map[[16]byte]principalEntry)}
+	userEvents.Subscribe(cache.InvalidateUser)
+	events.Subscribe(cache.Invalidate)
+	return cache
+}
+
+// Resolve возвращает пользователя с ID userID из subject access токена
+// Неизвестный или удаленный пользователь - 401 auth.invalid_token, неактивный - 403 auth.user_inactive
+func (c *PrincipalCache) Resolve(ctx context.Context, userID string) (*auth.Principal, error) {
+	id, err := mapper.ParseUUID(userID)
+	if err != nil {
+		return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+	}
+
+	c.mu.RLock()
+	entry, ok := c.entries[id.Bytes]
+	generation := c.generation
+	c.mu.RUnlock()
+
+	if !ok {
+		entry, err = c.load(ctx, id)
+		if domain_error.Is(err, domain_error.KindNotFound) {
+			return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		c.store(id, entry, generation)
+	}
+
+	if !entry.active {
+		return nil, domain_error.Forbidden("auth.user_inactive")
+	}
+	principal := entry.principal
+	return &principal, nil
+}
+
+// InvalidateUser сбрасывает запись пользователя; следующий запрос перечитает его из БД
+func (c *PrincipalCache) InvalidateUser(userID pgtype.UUID) {
+	c.mu.Lock()
+	delete(c.entries, userID.Bytes)
+	c.generation++
+	c.mu.Unlock()
+}
+
+// Invalidate сбрасывает записи всех пользователей
+func (c *PrincipalCache) Invalidate() {
+	c.mu.Lock()
+	c.entries = make(map[[16]byte]principalEntry)
+	c.generation++
+	c.mu.Unlock()
+}
+
+// Listen подписывается на UserNotifyChannel и NotifyChannel
+// Уведомление с ID пользователя сбрасывает его запись, изменение таблицы roles и пустой ID - весь кэш
+// Блокируется до отмены ctx; при потере соединения переподключается и сбрасывает кэш
+func (c *PrincipalCache) Listen(ctx context.Context, pool *pgxpool.Pool) {
+	listen(ctx, pool, "Principal cache", []string{UserNotifyChannel, NotifyChannel}, c.notify, c.Invalidate)
+}
+
+func (c *PrincipalCache) notify(notification *pgconn.Notification) {
+	if notification.Channel == NotifyChannel {
+		if notification.Payload == rolesTable {
+			c.Invalidate()
+		}
+		return
+	}
+
+	userID, err := mapper.ParseUUID(notification.Payload)
+	if err != nil {
+		c.Invalidate()
+		return
+	}
+	c.InvalidateUser(userID)
+}
+
+// store сохраняет загруженную запись, если кэш не сбрасывали с начала загрузки
+// Иначе запись могла устареть и используется только для текущего запроса
+func (c *PrincipalCache) store(id pgtype.UUID, entry principalEntry, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	if len(c.entries) >= maxPrincipals {
+		c.entries = make(map[[16]byte]principalEntry)
+	}
+	c.entries[id.Bytes] = entry
+}
+
+// load читает пользователя и значения его ролей с основной БД
+// Контекст отвязан от отмены запроса, как в PermissionCache.load
+func (c *PrincipalCache) load(ctx context.Context, id pgtype.UUID) (principalEntry, error) {
+	ctx, cancel := context.WithTimeout(db.WithPrimary(context.WithoutCancel(ctx)), loadTimeout)
+	defer cancel()
+
+	userSQLC, err := c.users.GetById(ctx, id)
+	if err != nil {
+		return principalEntry{}, err
+	}
+	roles, err := c.users.GetRoleValues(ctx, id)
+	if err != nil {
+		return principalEntry{}, err
+	}
+	return principalEntry{
+		principal: mapper.PrincipalFromUserSQLC(*userSQLC, roles),
+		active:    userSQLC.IsActive,
+	}, nil
+}
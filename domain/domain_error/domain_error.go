@@ -0,0 +1,200 @@
+package domain_error
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Kind - категория доменной ошибки, определяет HTTP статус и код ответа
+type Kind string
+
+const (
+	KindNotFound             Kind = "not_found"
+	KindConflict             Kind = "conflict"
+	KindValidation           Kind = "validation"
+	KindUnauthorized         Kind = "unauthorized"
+	KindForbidden            Kind = "forbidden"
+	KindRateLimited          Kind = "too_many_requests"
+	KindPreconditionFailed   Kind = "precondition_failed"
+	KindPreconditionRequired Kind = "precondition_required"
+	KindInternal             Kind = "internal"
+)
+
+// HTTPStatus возвращает HTTP статус для категории ошибки
+func (k Kind) HTTPStatus() int {
+	switch k {
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindValidation:
+		return http.StatusUnprocessableEntity
+	case KindUnauthorized:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindRateLimited:
+		return http.StatusTooManyRequests
+	case KindPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case KindPreconditionRequired:
+		return http.StatusPreconditionRequired
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// MessageID возвращает i18n ключ общего сообщения для категории ошибки
+func (k Kind) MessageID() string {
+	switch k {
+	case KindNotFound:
+		return "error.not_found"
+	case KindConflict:
+		return "error.conflict"
+	case KindValidation:
+		return "error.validation"
+	case KindUnauthorized:
+		return "error.unauthorized"
+	case KindForbidden:
+		return "error.forbidden"
+	case KindRateLimited:
+		return "error.too_many_requests"
+	case KindPreconditionFailed:
+		return "error.precondition_failed"
+	case KindPreconditionRequired:
+		return "error.precondition_required"
+	default:
+		return "error.internal_server"
+	}
+}
+
+// FieldError - нарушение правила проверки для одного поля запроса
+// Field - имя поля в запросе (json), Rule - нарушенное правило (required, max, slug, ...)
+type FieldError struct {
+	Field        string
+	Rule         string
+	MessageID    string
+	TemplateData map[string]interface{}
+}
+
+// Error - доменная ошибка
+// MessageID - i18n ключ сообщения для клиента (например, "role.not_found")
+// Fields - ошибки по полям для KindValidation
+// Current - актуальное представление ресурса для KindPreconditionFailed
+// Cause - исходная ошибка, клиенту не показывается
+type Error struct {
+	Kind         Kind
+	MessageID    string
+	TemplateData map[string]interface{}
+	Fields       []FieldError
+	Current      any
+	Cause        error
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return string(e.Kind) + ": " + e.Key() + ": " + e.Cause.Error()
+	}
+	return string(e.Kind) + ": " + e.Key()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// WithCause сохраняет исходную ошибку
+func (e *Error) WithCause(cause error) *Error {
+	e.Cause = cause
+	return e
+}
+
+// WithData добавляет шаблонные переменные для перевода сообщения
+func (e *Error) WithData(data map[string]interface{}) *Error {
+	e.TemplateData = data
+	return e
+}
+
+// WithFields добавляет ошибки по полям
+func (e *Error) WithFields(fields []FieldError) *Error {
+	e.Fields = fields
+	return e
+}
+
+// WithCurrent добавляет актуальное представление ресурса
+func (e *Error) WithCurrent(current any) *Error {
+	e.Current = current
+	return e
+}
+
+// Key возвращает i18n ключ сообщения: собственный или общий для категории
+func (e *Error) Key() string {
+	if e.MessageID != "" {
+		return e.MessageID
+	}
+	return e.Kind.MessageID()
+}
+
+// New создает доменную ошибку указанной категории
+// Если messageID пуст, используется общее сообщение категории
+func New(kind Kind, messageID string) *Error {
+	return &Error{Kind: kind, MessageID: messageID}
+}
+
+func NotFound(messageID string) *Error {
+	return New(KindNotFound, messageID)
+}
+
+func Conflict(messageID string) *Error {
+	return New(KindConflict, messageID)
+}
+
+func Validation(messageID string) *Error {
+	return New(KindValidation, messageID)
+}
+
+func Unauthorized(messageID string) *Error {
+	return New(KindUnauthorized, messageID)
+}
+
+func Forbidden(messageID string) *Error {
+	return New(KindForbidden, messageID)
+}
+
+func RateLimited(messageID string) *Error {
+	return New(KindRateLimited, messageID)
+}
+
+func PreconditionFailed(messageID string) *Error {
+	return New(KindPreconditionFailed, messageID)
+}
+
+func PreconditionRequired(messageID string) *Error {
+	return New(KindPreconditionRequired, messageID)
+}
+
+// Internal оборачивает непредвиденную ошибку: клиент получит общее сообщение, причина попадет в лог
+func Internal(cause error) *Error {
+	return New(KindInternal, "").WithCause(cause)
+}
+
+// As извлекает доменную ошибку из цепочки
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
+
+// KindOf возвращает категорию ошибки; ошибки вне доменной модели считаются внутренними
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Kind
+	}
+	return KindInternal
+}
+
+// Is проверяет, относится ли ошибка к указанной категории
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
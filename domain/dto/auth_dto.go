@@ -0,0 +1,22 @@
+package dto
+
+// LoginDTO используется для входа по email и паролю
+type LoginDTO struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+// RefreshTokenDTO используется для выпуска новой пары токенов по refresh токену
+type RefreshTokenDTO struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// TokenPairRDTO - ответ на вход и обновление токенов
+// ExpiresIn и RefreshExpiresIn - время жизни токенов в секундах
+type TokenPairRDTO struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshExpiresIn int64  `json:"refresh_expires_in"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+// ErrorRDTO - единый формат ответа с ошибкой
+// Code - машиночитаемый код (not_found, conflict, ...), Message - сообщение на языке запроса
+// Errors - ошибки по полям запроса (только для code = validation)
+// Current - актуальное представление ресурса (только для code = precondition_failed)
+type ErrorRDTO struct {
+	Status    int              `json:"status"`
+	Code      string           `json:"code"`
+	Message   string           `json:"message"`
+	Errors    []FieldErrorRDTO `json:"errors,omitempty"`
+	Current   any              `json:"current,omitempty"`
+	RequestID string           `json:"request_id,omitempty"`
+}
+
+// FieldErrorRDTO - нарушение правила проверки для одного поля запроса
+type FieldErrorRDTO struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
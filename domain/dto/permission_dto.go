@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"clean_architecture_fiber/pkg/etag"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -8,13 +9,14 @@ import (
 
 // PermissionDTO используется для операций создания/обновления разрешений
 type PermissionDTO struct {
-	ID          pgtype.UUID `json:"id,omitempty"`
-	TitleRu     string      `json:"title_ru"`
-	TitleEn     string      `json:"title_en"`
-	TitleKk     string      `json:"title_kk"`
-	Value       string      `json:"value"`
-	Description string      `json:"description"`
-	IsActive    bool        `json:"is_active"`
+	ID            pgtype.UUID `json:"id,omitempty"`
+	TitleRu       string      `json:"title_ru" validate:"required,max=255"`
+	TitleEn       string      `json:"title_en" validate:"max=255"`
+	TitleKk       string      `json:"title_kk" validate:"max=255"`
+	DescriptionRu string      `json:"description_ru" validate:"required"`
+	DescriptionEn string      `json:"description_en"`
+	DescriptionKk string      `json:"description_kk"`
+	Value         string      `json:"value" validate:"required,max=280,slug"`
 }
 
 // PermissionRDTO используется для чтения (Read) разрешений с автоматической локализацией
@@ -28,3 +30,8 @@ type PermissionRDTO struct {
 	UpdatedAt   time.Time  `json:"updated_at"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
+
+// ETag возвращает версию разрешения для заголовков ETag / If-Match
+func (r PermissionRDTO) ETag() string {
+	return etag.FromTime(r.UpdatedAt)
+}
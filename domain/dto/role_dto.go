@@ -1,9 +1,24 @@
 package dto
 
 import (
+	"clean_architecture_fiber/pkg/etag"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// RoleDTO используется для операций создания/обновления ролей
+type RoleDTO struct {
+	ID            pgtype.UUID `json:"id,omitempty"`
+	TitleRu       string      `json:"title_ru" validate:"required,max=255"`
+	TitleEn       string      `json:"title_en" validate:"max=255"`
+	TitleKk       string      `json:"title_kk" validate:"max=255"`
+	DescriptionRu string      `json:"description_ru" validate:"required"`
+	DescriptionEn string      `json:"description_en"`
+	DescriptionKk string      `json:"description_kk"`
+	Value         string      `json:"value" validate:"required,max=280,slug"`
+}
+
 // RoleRDTO используется для чтения (Read) ролей с автоматической локализацией
 // Title и Description автоматически выбираются на основе языка запроса
 type RoleRDTO struct {
@@ -15,3 +30,8 @@ type RoleRDTO struct {
 	UpdatedAt   time.Time  `json:"updated_at"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
+
+// ETag возвращает версию роли для заголовков ETag / If-Match
+func (r RoleRDTO) ETag() string {
+	return etag.FromTime(r.UpdatedAt)
+}
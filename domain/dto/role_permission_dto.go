@@ -1 +1,25 @@
 package dto
+
+import "time"
+
+// RolePermissionsDTO используется для назначения/снятия/замены набора разрешений роли
+type RolePermissionsDTO struct {
+	PermissionIDs []string `json:"permission_ids" validate:"dive,uuid"`
+}
+
+// RolePermissionCheckRDTO используется для ответа на проверку наличия разрешения у роли
+type RolePermissionCheckRDTO struct {
+	Role          string `json:"role"`
+	Permission    string `json:"permission"`
+	HasPermission bool   `json:"has_permission"`
+}
+
+// RolePermissionRDTO используется для чтения (Read) связи роль-разрешение с локализованными ролью и разрешением
+type RolePermissionRDTO struct {
+	ID           string          `json:"id"`
+	RoleID       string          `json:"role_id"`
+	PermissionID string          `json:"permission_id"`
+	CreatedAt    time.Time       `json:"created_at"`
+	Role         *RoleRDTO       `json:"role,omitempty"`
+	Permission   *PermissionRDTO `json:"permission,omitempty"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+// TransferRecordDTO - роль или разрешение в файле импорта/экспорта
+// ID не переносится: между окружениями записи сопоставляются по value
+type TransferRecordDTO struct {
+	Value         string `json:"value" validate:"required,max=280,slug"`
+	TitleRu       string `json:"title_ru" validate:"required,max=255"`
+	TitleEn       string `json:"title_en" validate:"max=255"`
+	TitleKk       string `json:"title_kk" validate:"max=255"`
+	DescriptionRu string `json:"description_ru" validate:"required"`
+	DescriptionEn string `json:"description_en"`
+	DescriptionKk string `json:"description_kk"`
+}
+
+// ImportDTO - входные данные импорта ролей или разрешений
+// Rows собирается из тела запроса в формате JSON или CSV (см. transfer.Decode)
+type ImportDTO struct {
+	Strategy     string              `query:"strategy"`
+	ValidateOnly bool                `query:"validate_only"`
+	Rows         []TransferRecordDTO `json:"rows" validate:"dive"`
+}
+
+// ImportRowRDTO - результат импорта одной строки
+// Row - номер строки с нуля (для CSV - без заголовка), Status - created, updated, unchanged или skipped
+type ImportRowRDTO struct {
+	Row    int    `json:"row"`
+	Value  string `json:"value"`
+	Status string `json:"status"`
+}
+
+// ImportReportRDTO - отчет об импорте
+// При validate_only = true изменения не записываются, статусы показывают, что произошло бы
+type ImportReportRDTO struct {
+	ValidateOnly bool            `json:"validate_only"`
+	Strategy     string          `json:"strategy"`
+	Total        int             `json:"total"`
+	Created      int             `json:"created"`
+	Updated      int             `json:"updated"`
+	Unchanged    int             `json:"unchanged"`
+	Skipped      int             `json:"skipped"`
+	Rows         []ImportRowRDTO `json:"rows"`
+}
+
+// ExportDTO - входные данные экспорта ролей или разрешений
+type ExportDTO struct {
+	Format string `query:"format"`
+}
+
+// FileRDTO - ответ в виде файла для скачивания (см. handler.Downloadable)
+type FileRDTO struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Download возвращает имя, тип содержимого и содержимое файла
+func (f *FileRDTO) Download() (string, string, []byte) {
+	return f.Name, f.ContentType, f.Content
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// PurgeRunRDTO - итог последней очистки корзины для одной таблицы
+// Failed означает, что запуск прервался ошибкой, и записи удалены частично
+type PurgeRunRDTO struct {
+	Table      string    `json:"table"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Retention  string    `json:"retention"`
+	Count      int       `json:"count"`
+	IDs        []string  `json:"ids"`
+	Values     []string  `json:"values"`
+	Failed     bool      `json:"failed"`
+}
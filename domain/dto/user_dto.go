@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// CreateUserDTO используется для создания пользователя
+// Пароль хешируется bcrypt, поэтому ограничен 72 байтами
+type CreateUserDTO struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	FullName string `json:"full_name" validate:"max=255"`
+	IsActive *bool  `json:"is_active"`
+}
+
+// UpdateUserDTO используется для обновления профиля пользователя
+// Email и пароль меняются через UpdateUserCredentialsDTO
+type UpdateUserDTO struct {
+	FullName string `json:"full_name" validate:"max=255"`
+	IsActive *bool  `json:"is_active" validate:"required"`
+}
+
+// UpdateUserCredentialsDTO используется для смены email и пароля пользователя
+// Пустой Password оставляет текущий пароль
+type UpdateUserCredentialsDTO struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"omitempty,min=8,max=72"`
+}
+
+// UserRDTO используется для чтения (Read) пользователей
+// Хеш пароля никогда не попадает в ответ
+type UserRDTO struct {
+	ID        string     `json:"id"`
+	Email     string     `json:"email"`
+	FullName  string     `json:"full_name"`
+	IsActive  bool       `json:"is_active"`
+	Roles     []string   `json:"roles"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
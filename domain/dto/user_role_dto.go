@@ -0,0 +1,13 @@
+package dto
+
+// UserRolesDTO используется для назначения/снятия/замены набора ролей пользователя
+type UserRolesDTO struct {
+	RoleIDs []string `json:"role_ids" validate:"dive,uuid"`
+}
+
+// UserPermissionCheckRDTO используется для ответа на проверку наличия разрешения у пользователя
+type UserPermissionCheckRDTO struct {
+	UserID        string `json:"user_id"`
+	Permission    string `json:"permission"`
+	HasPermission bool   `json:"has_permission"`
+}
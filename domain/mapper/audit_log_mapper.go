@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"time"
+)
+
+// AuditLogRDTOFromAuditLogSQLC преобразует generated.AuditLog (sqlc) в dto.AuditLogRDTO
+func AuditLogRDTOFromAuditLogSQLC(auditLogSQLC generated.AuditLog) dto.AuditLogRDTO {
+	var createdAt time.Time
+	if auditLogSQLC.CreatedAt.Valid {
+		createdAt = auditLogSQLC.CreatedAt.Time
+	}
+
+	return dto.AuditLogRDTO{
+		ID:         uuidToString(auditLogSQLC.ID),
+		ActorID:    uuidToString(auditLogSQLC.ActorID),
+		ActorEmail: auditLogSQLC.ActorEmail.String,
+		Action:     auditLogSQLC.Action,
+		Entity:     auditLogSQLC.EntityType,
+		EntityID:   uuidToString(auditLogSQLC.EntityID),
+		Before:     auditLogSQLC.Before,
+		After:      auditLogSQLC.After,
+		RequestID:  auditLogSQLC.RequestID.String,
+		CreatedAt:  createdAt,
+	}
+}
+
+// AuditLogRDTOListFromAuditLogsSQLC преобразует список generated.AuditLog в список dto.AuditLogRDTO
+func AuditLogRDTOListFromAuditLogsSQLC(auditLogsSQLC []generated.AuditLog) []dto.AuditLogRDTO {
+	result := make([]dto.AuditLogRDTO, 0, len(auditLogsSQLC))
+	for _, auditLogSQLC := range auditLogsSQLC {
+		result = append(result, AuditLogRDTOFromAuditLogSQLC(auditLogSQLC))
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/pkg/auth"
+	"time"
+)
+
+// PrincipalFromUserSQLC собирает auth.Principal из generated.User (sqlc) и значений его ролей
+func PrincipalFromUserSQLC(userSQLC generated.User, roles []string) auth.Principal {
+	return auth.Principal{
+		UserID: uuidToString(userSQLC.ID),
+		Email:  userSQLC.Email,
+		Roles:  roles,
+	}
+}
+
+// SubjectFromUserSQLC возвращает ID пользователя generated.User (sqlc) для subject токена
+func SubjectFromUserSQLC(userSQLC generated.User) string {
+	return uuidToString(userSQLC.ID)
+}
+
+// TokenPairRDTOFromTokenPair преобразует auth.TokenPair в dto.TokenPairRDTO
+func TokenPairRDTOFromTokenPair(pair *auth.TokenPair) *dto.TokenPairRDTO {
+	now := time.Now()
+	return &dto.TokenPairRDTO{
+		AccessToken:      pair.AccessToken,
+		RefreshToken:     pair.RefreshToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(pair.AccessExpiresAt.Sub(now).Seconds()),
+		RefreshExpiresIn: int64(pair.RefreshExpiresAt.Sub(now).Seconds()),
+	}
+}
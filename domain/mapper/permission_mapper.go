@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // PermissionRDTOFromPermissionSQLC преобразует generated.Permission (sqlc) в dto.PermissionRDTO
@@ -47,3 +48,160 @@ func PermissionRDTOFromPermissionSQLC(ctx *fiber.Ctx, permissionSQLC generated.P
 		DeletedAt:   deletedAt,
 	}
 }
+
+// PermissionRDTOFromPermissionByIdSQLC преобразует generated.GetPermissionByIdRow в dto.PermissionRDTO
+func PermissionRDTOFromPermissionByIdSQLC(ctx *fiber.Ctx, row generated.GetPermissionByIdRow) dto.PermissionRDTO {
+	return PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(generated.ListAllPermissionsRow(row)))
+}
+
+// PermissionRDTOFromPermissionByValueSQLC преобразует generated.GetPermissionByValueRow в dto.PermissionRDTO
+func PermissionRDTOFromPermissionByValueSQLC(ctx *fiber.Ctx, row generated.GetPermissionByValueRow) dto.PermissionRDTO {
+	return PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(generated.ListAllPermissionsRow(row)))
+}
+
+// PermissionRDTOListFromListSQLC преобразует результат ListAllPermissions в список dto.PermissionRDTO
+func PermissionRDTOListFromListSQLC(ctx *fiber.Ctx, rows []generated.ListAllPermissionsRow) []dto.PermissionRDTO {
+	result := make([]dto.PermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(row)))
+	}
+	return result
+}
+
+// PermissionRDTOListFromPaginateSQLC преобразует результат PaginateAllPermissions в список dto.PermissionRDTO
+func PermissionRDTOListFromPaginateSQLC(ctx *fiber.Ctx, rows []generated.PaginateAllPermissionsRow) []dto.PermissionRDTO {
+	result := make([]dto.PermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(generated.ListAllPermissionsRow(row))))
+	}
+	return result
+}
+
+// PermissionRDTOListFromSeekAfterSQLC преобразует результат SeekPermissionsAfter в список dto.PermissionRDTO
+func PermissionRDTOListFromSeekAfterSQLC(ctx *fiber.Ctx, rows []generated.SeekPermissionsAfterRow) []dto.PermissionRDTO {
+	result := make([]dto.PermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(generated.ListAllPermissionsRow(row))))
+	}
+	return result
+}
+
+// PermissionRDTOListFromSeekBeforeSQLC преобразует результат SeekPermissionsBefore в список dto.PermissionRDTO
+func PermissionRDTOListFromSeekBeforeSQLC(ctx *fiber.Ctx, rows []generated.SeekPermissionsBeforeRow) []dto.PermissionRDTO {
+	result := make([]dto.PermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, PermissionRDTOFromPermissionSQLC(ctx, permissionFromRow(generated.ListAllPermissionsRow(row))))
+	}
+	return result
+}
+
+// PermissionRDTOListFromPermissionsSQLC преобразует список generated.Permission в список dto.PermissionRDTO
+func PermissionRDTOListFromPermissionsSQLC(ctx *fiber.Ctx, permissionsSQLC []generated.Permission) []dto.PermissionRDTO {
+	result := make([]dto.PermissionRDTO, 0, len(permissionsSQLC))
+	for _, permissionSQLC := range permissionsSQLC {
+		result = append(result, PermissionRDTOFromPermissionSQLC(ctx, permissionSQLC))
+	}
+	return result
+}
+
+// CreateOnePermissionParamsFromPermissionDTO преобразует dto.PermissionDTO в параметры sqlc запроса CreateOnePermission
+// Если ID не передан, генерируется новый UUID
+func CreateOnePermissionParamsFromPermissionDTO(permissionDTO dto.PermissionDTO) generated.CreateOnePermissionParams {
+	id := permissionDTO.ID
+	if !id.Valid {
+		id = NewUUID()
+	}
+
+	return generated.CreateOnePermissionParams{
+		ID:            id,
+		TitleRu:       permissionDTO.TitleRu,
+		TitleEn:       textToPgText(permissionDTO.TitleEn),
+		TitleKk:       textToPgText(permissionDTO.TitleKk),
+		DescriptionRu: permissionDTO.DescriptionRu,
+		DescriptionEn: textToPgText(permissionDTO.DescriptionEn),
+		DescriptionKk: textToPgText(permissionDTO.DescriptionKk),
+		Value:         permissionDTO.Value,
+	}
+}
+
+// UpdatePermissionByIdIfUnmodifiedParamsFromPermissionDTO - параметры условного обновления: updatedAt - версия, прочитанная перед обновлением
+func UpdatePermissionByIdIfUnmodifiedParamsFromPermissionDTO(id pgtype.UUID, permissionDTO dto.PermissionDTO, updatedAt pgtype.Timestamp) generated.UpdatePermissionByIdIfUnmodifiedParams {
+	return generated.UpdatePermissionByIdIfUnmodifiedParams{
+		ID:            id,
+		TitleRu:       permissionDTO.TitleRu,
+		TitleEn:       textToPgText(permissionDTO.TitleEn),
+		TitleKk:       textToPgText(permissionDTO.TitleKk),
+		DescriptionRu: permissionDTO.DescriptionRu,
+		DescriptionEn: textToPgText(permissionDTO.DescriptionEn),
+		DescriptionKk: textToPgText(permissionDTO.DescriptionKk),
+		Value:         permissionDTO.Value,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// permissionFromRow отбрасывает агрегированные роли из строки sqlc и возвращает generated.Permission
+func permissionFromRow(row generated.ListAllPermissionsRow) generated.Permission {
+	return generated.Permission{
+		ID:            row.ID,
+		TitleRu:       row.TitleRu,
+		TitleEn:       row.TitleEn,
+		TitleKk:       row.TitleKk,
+		DescriptionRu: row.DescriptionRu,
+		DescriptionKk: row.DescriptionKk,
+		DescriptionEn: row.DescriptionEn,
+		Value:         row.Value,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		DeletedAt:     row.DeletedAt,
+	}
+}
+
+// TransferRecordFromPermissionSQLC преобразует generated.Permission в строку файла импорта/экспорта
+func TransferRecordFromPermissionSQLC(permission generated.Permission) dto.TransferRecordDTO {
+	return dto.TransferRecordDTO{
+		Value:         permission.Value,
+		TitleRu:       permission.TitleRu,
+		TitleEn:       pgTextToString(permission.TitleEn),
+		TitleKk:       pgTextToString(permission.TitleKk),
+		DescriptionRu: permission.DescriptionRu,
+		DescriptionEn: pgTextToString(permission.DescriptionEn),
+		DescriptionKk: pgTextToString(permission.DescriptionKk),
+	}
+}
+
+// TransferRecordListFromPermissionsSQLC преобразует список generated.Permission в строки файла экспорта
+func TransferRecordListFromPermissionsSQLC(permissions []generated.Permission) []dto.TransferRecordDTO {
+	result := make([]dto.TransferRecordDTO, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, TransferRecordFromPermissionSQLC(permission))
+	}
+	return result
+}
+
+// BulkCreatePermissionsParamsFromTransferRecord преобразует строку импорта в параметры BulkCreatePermissions с новым UUID
+func BulkCreatePermissionsParamsFromTransferRecord(record dto.TransferRecordDTO) generated.BulkCreatePermissionsParams {
+	return generated.BulkCreatePermissionsParams{
+		ID:            NewUUID(),
+		TitleRu:       record.TitleRu,
+		TitleEn:       textToPgText(record.TitleEn),
+		TitleKk:       textToPgText(record.TitleKk),
+		DescriptionRu: record.DescriptionRu,
+		DescriptionEn: textToPgText(record.DescriptionEn),
+		DescriptionKk: textToPgText(record.DescriptionKk),
+		Value:         record.Value,
+	}
+}
+
+// BulkUpdatePermissionsParamsFromTransferRecord преобразует строку импорта в параметры BulkUpdatePermissions для записи id
+func BulkUpdatePermissionsParamsFromTransferRecord(id pgtype.UUID, record dto.TransferRecordDTO) generated.BulkUpdatePermissionsParams {
+	return generated.BulkUpdatePermissionsParams{
+		ID:            id,
+		TitleRu:       record.TitleRu,
+		TitleEn:       textToPgText(record.TitleEn),
+		TitleKk:       textToPgText(record.TitleKk),
+		DescriptionRu: record.DescriptionRu,
+		DescriptionEn: textToPgText(record.DescriptionEn),
+		DescriptionKk: textToPgText(record.DescriptionKk),
+		Value:         record.Value,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // RoleRDTOFromRoleSQLC преобразует generated.Role (sqlc) в dto.RoleRDTO
@@ -51,3 +52,165 @@ func RoleRDTOFromRoleSQLC(ctx *fiber.Ctx, roleSQLC *generated.GetRoleByValueRow)
 	return nil
 
 }
+
+// RoleRDTOFromRoleModelSQLC преобразует generated.Role (результат INSERT/UPDATE/DELETE) в dto.RoleRDTO
+func RoleRDTOFromRoleModelSQLC(ctx *fiber.Ctx, role generated.Role) *dto.RoleRDTO {
+	row := generated.GetRoleByValueRow{
+		ID:            role.ID,
+		TitleRu:       role.TitleRu,
+		TitleEn:       role.TitleEn,
+		TitleKk:       role.TitleKk,
+		DescriptionRu: role.DescriptionRu,
+		DescriptionKk: role.DescriptionKk,
+		DescriptionEn: role.DescriptionEn,
+		Value:         role.Value,
+		CreatedAt:     role.CreatedAt,
+		UpdatedAt:     role.UpdatedAt,
+		DeletedAt:     role.DeletedAt,
+	}
+	return RoleRDTOFromRoleSQLC(ctx, &row)
+}
+
+// RoleRDTOFromRoleByIdSQLC преобразует generated.GetRoleByIdRow в dto.RoleRDTO
+// Структура строки совпадает с GetRoleByValueRow, поэтому используется прямое приведение типов
+func RoleRDTOFromRoleByIdSQLC(ctx *fiber.Ctx, roleSQLC *generated.GetRoleByIdRow) *dto.RoleRDTO {
+	if roleSQLC == nil {
+		return nil
+	}
+	row := generated.GetRoleByValueRow(*roleSQLC)
+	return RoleRDTOFromRoleSQLC(ctx, &row)
+}
+
+// RoleRDTOListFromListSQLC преобразует результат ListAllRoles в список dto.RoleRDTO
+func RoleRDTOListFromListSQLC(ctx *fiber.Ctx, rolesSQLC []generated.ListAllRolesRow) []dto.RoleRDTO {
+	result := make([]dto.RoleRDTO, 0, len(rolesSQLC))
+	for _, roleSQLC := range rolesSQLC {
+		row := generated.GetRoleByValueRow(roleSQLC)
+		result = append(result, *RoleRDTOFromRoleSQLC(ctx, &row))
+	}
+	return result
+}
+
+// RoleRDTOListFromPaginateSQLC преобразует результат PaginateAllRoles в список dto.RoleRDTO
+func RoleRDTOListFromPaginateSQLC(ctx *fiber.Ctx, rolesSQLC []generated.PaginateAllRolesRow) []dto.RoleRDTO {
+	result := make([]dto.RoleRDTO, 0, len(rolesSQLC))
+	for _, roleSQLC := range rolesSQLC {
+		row := generated.GetRoleByValueRow(roleSQLC)
+		result = append(result, *RoleRDTOFromRoleSQLC(ctx, &row))
+	}
+	return result
+}
+
+// RoleRDTOListFromSeekAfterSQLC преобразует результат SeekRolesAfter в список dto.RoleRDTO
+func RoleRDTOListFromSeekAfterSQLC(ctx *fiber.Ctx, rolesSQLC []generated.SeekRolesAfterRow) []dto.RoleRDTO {
+	result := make([]dto.RoleRDTO, 0, len(rolesSQLC))
+	for _, roleSQLC := range rolesSQLC {
+		row := generated.GetRoleByValueRow(roleSQLC)
+		result = append(result, *RoleRDTOFromRoleSQLC(ctx, &row))
+	}
+	return result
+}
+
+// RoleRDTOListFromSeekBeforeSQLC преобразует результат SeekRolesBefore в список dto.RoleRDTO
+func RoleRDTOListFromSeekBeforeSQLC(ctx *fiber.Ctx, rolesSQLC []generated.SeekRolesBeforeRow) []dto.RoleRDTO {
+	result := make([]dto.RoleRDTO, 0, len(rolesSQLC))
+	for _, roleSQLC := range rolesSQLC {
+		row := generated.GetRoleByValueRow(roleSQLC)
+		result = append(result, *RoleRDTOFromRoleSQLC(ctx, &row))
+	}
+	return result
+}
+
+// CreateOneRoleParamsFromRoleDTO преобразует dto.RoleDTO в параметры sqlc запроса CreateOneRole
+// Если ID не передан, генерируется новый UUID
+func CreateOneRoleParamsFromRoleDTO(roleDTO dto.RoleDTO) generated.CreateOneRoleParams {
+	id := roleDTO.ID
+	if !id.Valid {
+		id = NewUUID()
+	}
+
+	return generated.CreateOneRoleParams{
+		ID:            id,
+		TitleRu:       roleDTO.TitleRu,
+		TitleEn:       textToPgText(roleDTO.TitleEn),
+		TitleKk:       textToPgText(roleDTO.TitleKk),
+		DescriptionRu: roleDTO.DescriptionRu,
+		DescriptionEn: textToPgText(roleDTO.DescriptionEn),
+		DescriptionKk: textToPgText(roleDTO.DescriptionKk),
+		Value:         roleDTO.Value,
+	}
+}
+
+// UpdateRoleByIdIfUnmodifiedParamsFromRoleDTO - параметры условного обновления: updatedAt - версия, прочитанная перед обновлением
+func UpdateRoleByIdIfUnmodifiedParamsFromRoleDTO(id pgtype.UUID, roleDTO dto.RoleDTO, updatedAt pgtype.Timestamp) generated.UpdateRoleByIdIfUnmodifiedParams {
+	return generated.UpdateRoleByIdIfUnmodifiedParams{
+		ID:            id,
+		TitleRu:       roleDTO.TitleRu,
+		TitleEn:       textToPgText(roleDTO.TitleEn),
+		TitleKk:       textToPgText(roleDTO.TitleKk),
+		DescriptionRu: roleDTO.DescriptionRu,
+		DescriptionEn: textToPgText(roleDTO.DescriptionEn),
+		DescriptionKk: textToPgText(roleDTO.DescriptionKk),
+		Value:         roleDTO.Value,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// RoleRDTOListFromRolesSQLC преобразует список generated.Role в список dto.RoleRDTO
+func RoleRDTOListFromRolesSQLC(ctx *fiber.Ctx, rolesSQLC []generated.Role) []dto.RoleRDTO {
+	result := make([]dto.RoleRDTO, 0, len(rolesSQLC))
+	for _, roleSQLC := range rolesSQLC {
+		result = append(result, *RoleRDTOFromRoleModelSQLC(ctx, roleSQLC))
+	}
+	return result
+}
+
+// TransferRecordFromRoleSQLC преобразует generated.Role в строку файла импорта/экспорта
+func TransferRecordFromRoleSQLC(role generated.Role) dto.TransferRecordDTO {
+	return dto.TransferRecordDTO{
+		Value:         role.Value,
+		TitleRu:       role.TitleRu,
+		TitleEn:       pgTextToString(role.TitleEn),
+		TitleKk:       pgTextToString(role.TitleKk),
+		DescriptionRu: role.DescriptionRu,
+		DescriptionEn: pgTextToString(role.DescriptionEn),
+		DescriptionKk: pgTextToString(role.DescriptionKk),
+	}
+}
+
+// TransferRecordListFromRolesSQLC преобразует список generated.Role в строки файла экспорта
+func TransferRecordListFromRolesSQLC(roles []generated.Role) []dto.TransferRecordDTO {
+	result := make([]dto.TransferRecordDTO, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, TransferRecordFromRoleSQLC(role))
+	}
+	return result
+}
+
+// BulkCreateRolesParamsFromTransferRecord преобразует строку импорта в параметры BulkCreateRoles с новым UUID
+func BulkCreateRolesParamsFromTransferRecord(record dto.TransferRecordDTO) generated.BulkCreateRolesParams {
+	return generated.BulkCreateRolesParams{
+		ID:            NewUUID(),
+		TitleRu:       record.TitleRu,
+		TitleEn:       textToPgText(record.TitleEn),
+		TitleKk:       textToPgText(record.TitleKk),
+		DescriptionRu: record.DescriptionRu,
+		DescriptionEn: textToPgText(record.DescriptionEn),
+		DescriptionKk: textToPgText(record.DescriptionKk),
+		Value:         record.Value,
+	}
+}
+
+// BulkUpdateRolesParamsFromTransferRecord преобразует строку импорта в параметры BulkUpdateRoles для записи id
+func BulkUpdateRolesParamsFromTransferRecord(id pgtype.UUID, record dto.TransferRecordDTO) generated.BulkUpdateRolesParams {
+	return generated.BulkUpdateRolesParams{
+		ID:            id,
+		TitleRu:       record.TitleRu,
+		TitleEn:       textToPgText(record.TitleEn),
+		TitleKk:       textToPgText(record.TitleKk),
+		DescriptionRu: record.DescriptionRu,
+		DescriptionEn: textToPgText(record.DescriptionEn),
+		DescriptionKk: textToPgText(record.DescriptionKk),
+		Value:         record.Value,
+	}
+}
@@ -0,0 +1,84 @@
+package mapper
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"encoding/json"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RolePermissionRDTOFromPaginateSQLC преобразует generated.PaginateAllRolePermissionsRow в dto.RolePermissionRDTO
+// Роль и разрешение приходят из БД как JSON (json_build_object) и локализуются так же, как при прямом чтении
+func RolePermissionRDTOFromPaginateSQLC(ctx *fiber.Ctx, row generated.PaginateAllRolePermissionsRow) (dto.RolePermissionRDTO, error) {
+	var createdAt time.Time
+	if row.CreatedAt.Valid {
+		createdAt = row.CreatedAt.Time
+	}
+
+	result := dto.RolePermissionRDTO{
+		ID:           uuidToString(row.ID),
+		RoleID:       uuidToString(row.RoleID),
+		PermissionID: uuidToString(row.PermissionID),
+		CreatedAt:    createdAt,
+	}
+
+	if len(row.Role) > 0 {
+		var role generated.Role
+		if err := json.Unmarshal(row.Role, &role); err != nil {
+			return dto.RolePermissionRDTO{}, err
+		}
+		result.Role = RoleRDTOFromRoleModelSQLC(ctx, role)
+	}
+
+	if len(row.Permission) > 0 {
+		var permission generated.Permission
+		if err := json.Unmarshal(row.Permission, &permission); err != nil {
+			return dto.RolePermissionRDTO{}, err
+		}
+		permissionRDTO := PermissionRDTOFromPermissionSQLC(ctx, permission)
+		result.Permission = &permissionRDTO
+	}
+
+	return result, nil
+}
+
+// RolePermissionRDTOListFromPaginateSQLC преобразует результат PaginateAllRolePermissions в список dto.RolePermissionRDTO
+func RolePermissionRDTOListFromPaginateSQLC(ctx *fiber.Ctx, rows []generated.PaginateAllRolePermissionsRow) ([]dto.RolePermissionRDTO, error) {
+	result := make([]dto.RolePermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		item, err := RolePermissionRDTOFromPaginateSQLC(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// RolePermissionRDTOListFromSeekAfterSQLC преобразует результат SeekRolePermissionsAfter в список dto.RolePermissionRDTO
+func RolePermissionRDTOListFromSeekAfterSQLC(ctx *fiber.Ctx, rows []generated.SeekRolePermissionsAfterRow) ([]dto.RolePermissionRDTO, error) {
+	result := make([]dto.RolePermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		item, err := RolePermissionRDTOFromPaginateSQLC(ctx, generated.PaginateAllRolePermissionsRow(row))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// RolePermissionRDTOListFromSeekBeforeSQLC преобразует результат SeekRolePermissionsBefore в список dto.RolePermissionRDTO
+func RolePermissionRDTOListFromSeekBeforeSQLC(ctx *fiber.Ctx, rows []generated.SeekRolePermissionsBeforeRow) ([]dto.RolePermissionRDTO, error) {
+	result := make([]dto.RolePermissionRDTO, 0, len(rows))
+	for _, row := range rows {
+		item, err := RolePermissionRDTOFromPaginateSQLC(ctx, generated.PaginateAllRolePermissionsRow(row))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
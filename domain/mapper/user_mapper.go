@@ -0,0 +1,101 @@
+package mapper
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UserRDTOFromUserSQLC преобразует generated.User (sqlc) и значения его ролей в dto.UserRDTO
+func UserRDTOFromUserSQLC(userSQLC generated.User, roles []string) dto.UserRDTO {
+	var createdAt, updatedAt time.Time
+	var deletedAt *time.Time
+
+	if userSQLC.CreatedAt.Valid {
+		createdAt = userSQLC.CreatedAt.Time
+	}
+	if userSQLC.UpdatedAt.Valid {
+		updatedAt = userSQLC.UpdatedAt.Time
+	}
+	if userSQLC.DeletedAt.Valid {
+		t := userSQLC.DeletedAt.Time
+		deletedAt = &t
+	}
+
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return dto.UserRDTO{
+		ID:        uuidToString(userSQLC.ID),
+		Email:     userSQLC.Email,
+		FullName:  userSQLC.FullName.String,
+		IsActive:  userSQLC.IsActive,
+		Roles:     roles,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+		DeletedAt: deletedAt,
+	}
+}
+
+// UserRDTOListFromPaginateSQLC преобразует строки PaginateAllUsers в список dto.UserRDTO
+func UserRDTOListFromPaginateSQLC(rows []generated.PaginateAllUsersRow) []dto.UserRDTO {
+	result := make([]dto.UserRDTO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, UserRDTOFromUserSQLC(generated.User{
+			ID:           row.ID,
+			Email:        row.Email,
+			PasswordHash: row.PasswordHash,
+			FullName:     row.FullName,
+			IsActive:     row.IsActive,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+			DeletedAt:    row.DeletedAt,
+		}, row.Roles))
+	}
+	return result
+}
+
+// CreateOneUserParamsFromUserDTO преобразует dto.CreateUserDTO и хеш пароля в параметры sqlc запроса CreateOneUser
+// Если IsActive не передан, пользователь создается активным
+func CreateOneUserParamsFromUserDTO(userDTO dto.CreateUserDTO, passwordHash string) generated.CreateOneUserParams {
+	isActive := true
+	if userDTO.IsActive != nil {
+		isActive = *userDTO.IsActive
+	}
+
+	return generated.CreateOneUserParams{
+		ID:           NewUUID(),
+		Email:        NormalizeEmail(userDTO.Email),
+		PasswordHash: passwordHash,
+		FullName:     textToPgText(userDTO.FullName),
+		IsActive:     isActive,
+	}
+}
+
+// UpdateUserByIdParamsFromUserDTO преобразует dto.UpdateUserDTO в параметры sqlc запроса UpdateUserById
+func UpdateUserByIdParamsFromUserDTO(id pgtype.UUID, userDTO dto.UpdateUserDTO) generated.UpdateUserByIdParams {
+	return generated.UpdateUserByIdParams{
+		ID:       id,
+		FullName: textToPgText(userDTO.FullName),
+		IsActive: *userDTO.IsActive,
+	}
+}
+
+// UpdateUserCredentialsByIdParamsFromDTO преобразует dto.UpdateUserCredentialsDTO в параметры sqlc запроса UpdateUserCredentialsById
+// Пустой passwordHash сохраняет текущий пароль
+func UpdateUserCredentialsByIdParamsFromDTO(id pgtype.UUID, credentialsDTO dto.UpdateUserCredentialsDTO, passwordHash string) generated.UpdateUserCredentialsByIdParams {
+	return generated.UpdateUserCredentialsByIdParams{
+		ID:           id,
+		Email:        NormalizeEmail(credentialsDTO.Email),
+		PasswordHash: textToPgText(passwordHash),
+	}
+}
+
+// NormalizeEmail приводит email к виду, в котором он хранится и ищется: без пробелов по краям, в нижнем регистре
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
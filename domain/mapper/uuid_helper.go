@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ParseUUID преобразует строку в pgtype.UUID
+// Возвращает ошибку, если строка не является валидным UUID
+func ParseUUID(value string) (pgtype.UUID, error) {
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("invalid uuid '%s': %w", value, err)
+	}
+	return pgtype.UUID{Bytes: parsed, Valid: true}, nil
+}
+
+// ParseUUIDs преобразует список строк в список pgtype.UUID
+// Пустой список возвращается как nil (в SQL это NULL - фильтр не применяется)
+func ParseUUIDs(values []string) ([]pgtype.UUID, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	result := make([]pgtype.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := ParseUUID(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// NewUUID генерирует новый случайный pgtype.UUID (v4)
+func NewUUID() pgtype.UUID {
+	return pgtype.UUID{Bytes: uuid.New(), Valid: true}
+}
+
+// textToPgText преобразует строку в pgtype.Text
+// Пустая строка сохраняется как NULL (перевод отсутствует)
+func textToPgText(value string) pgtype.Text {
+	if value == "" {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: value, Valid: true}
+}
+
+// pgTextToString преобразует pgtype.Text в строку, NULL - пустая строка (обратное textToPgText)
+func pgTextToString(value pgtype.Text) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.String
+}
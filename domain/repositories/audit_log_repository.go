@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, params generated.CreateAuditLogParams) (*generated.AuditLog, error)
+	Paginate(ctx context.Context, params generated.PaginateAuditLogsParams) ([]generated.AuditLog, error)
+	Count(ctx context.Context, params generated.CountAuditLogsParams) (int64, error)
+}
+
+type auditLogRepository struct {
+	query *generated.Queries
+}
+
+func NewAuditLogRepository(query *generated.Queries) AuditLogRepository {
+	return &auditLogRepository{query: query}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, params generated.CreateAuditLogParams) (*generated.AuditLog, error) {
+	auditLogSQLC, err := r.query.CreateAuditLog(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "")
+	}
+	return &auditLogSQLC, nil
+}
+
+func (r *auditLogRepository) Paginate(ctx context.Context, params generated.PaginateAuditLogsParams) ([]generated.AuditLog, error) {
+	rows, err := r.query.PaginateAuditLogs(ctx, params)
+	return rows, translateError(err, "")
+}
+
+func (r *auditLogRepository) Count(ctx context.Context, params generated.CountAuditLogsParams) (int64, error) {
+	count, err := r.query.CountAuditLogs(ctx, params)
+	return count, translateError(err, "")
+}
@@ -0,0 +1,189 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PermissionRepository interface {
+	GetByValue(ctx context.Context, value string) (*generated.GetPermissionByValueRow, error)
+	GetById(ctx context.Context, id pgtype.UUID) (*generated.GetPermissionByIdRow, error)
+	Create(ctx context.Context, params generated.CreateOnePermissionParams) (*generated.Permission, error)
+	UpdateIfUnmodified(ctx context.Context, params generated.UpdatePermissionByIdIfUnmodifiedParams) (*generated.Permission, error)
+	Delete(ctx context.Context, id pgtype.UUID) (*generated.Permission, error)
+	Restore(ctx context.Context, id pgtype.UUID) (*generated.Permission, error)
+	HardDelete(ctx context.Context, id pgtype.UUID) error
+	Lock(ctx context.Context, id pgtype.UUID) (*generated.Permission, error)
+	LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedPermissionsParams) ([]generated.Permission, error)
+	BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error
+	LockByValues(ctx context.Context, values []string) ([]generated.Permission, error)
+	BulkCreate(ctx context.Context, params []generated.BulkCreatePermissionsParams) (int64, error)
+	BulkUpdate(ctx context.Context, params []generated.BulkUpdatePermissionsParams) ([]generated.Permission, error)
+	ListForExport(ctx context.Context) ([]generated.Permission, error)
+	List(ctx context.Context, params generated.ListAllPermissionsParams) ([]generated.ListAllPermissionsRow, error)
+	Paginate(ctx context.Context, params generated.PaginateAllPermissionsParams) ([]generated.PaginateAllPermissionsRow, error)
+	Count(ctx context.Context, params generated.CountAllPermissionsParams) (int64, error)
+	SeekAfter(ctx context.Context, params generated.SeekPermissionsAfterParams) ([]generated.SeekPermissionsAfterRow, error)
+	SeekBefore(ctx context.Context, params generated.SeekPermissionsBeforeParams) ([]generated.SeekPermissionsBeforeRow, error)
+}
+
+type permissionRepository struct {
+	query  *generated.Queries
+	events *RolePermissionEvents
+}
+
+func NewPermissionRepository(query *generated.Queries, events *RolePermissionEvents) PermissionRepository {
+	return &permissionRepository{query: query, events: events}
+}
+
+func (r *permissionRepository) GetByValue(ctx context.Context, value string) (*generated.GetPermissionByValueRow, error) {
+	permissionSQLC, err := r.query.GetPermissionByValue(ctx, value)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	return &permissionSQLC, nil
+}
+
+func (r *permissionRepository) GetById(ctx context.Context, id pgtype.UUID) (*generated.GetPermissionByIdRow, error) {
+	permissionSQLC, err := r.query.GetPermissionById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	return &permissionSQLC, nil
+}
+
+func (r *permissionRepository) Create(ctx context.Context, params generated.CreateOnePermissionParams) (*generated.Permission, error) {
+	permissionSQLC, err := r.query.CreateOnePermission(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	return &permissionSQLC, nil
+}
+
+// UpdateIfUnmodified обновляет строку, только если ее updated_at равен params.UpdatedAt
+// Иначе (строку изменили, удалили или ее нет) возвращает NotFound
+func (r *permissionRepository) UpdateIfUnmodified(ctx context.Context, params generated.UpdatePermissionByIdIfUnmodifiedParams) (*generated.Permission, error) {
+	permissionSQLC, err := r.query.UpdatePermissionByIdIfUnmodified(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	r.events.publish(ctx)
+	return &permissionSQLC, nil
+}
+
+func (r *permissionRepository) Delete(ctx context.Context, id pgtype.UUID) (*generated.Permission, error) {
+	permissionSQLC, err := r.query.DeletePermissionById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	r.events.publish(ctx)
+	return &permissionSQLC, nil
+}
+
+func (r *permissionRepository) Restore(ctx context.Context, id pgtype.UUID) (*generated.Permission, error) {
+	permissionSQLC, err := r.query.RestorePermissionById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	r.events.publish(ctx)
+	return &permissionSQLC, nil
+}
+
+func (r *permissionRepository) HardDelete(ctx context.Context, id pgtype.UUID) error {
+	err := r.query.HardDeletePermissionById(ctx, id)
+	r.events.publishOnSuccess(ctx, err)
+	return translateError(err, "permission.not_found")
+}
+
+// Lock читает строку (в том числе мягко удаленную) и блокирует ее до конца транзакции
+// Вызывается внутри TxManager.Do, чтобы получить состояние "до" изменения
+func (r *permissionRepository) Lock(ctx context.Context, id pgtype.UUID) (*generated.Permission, error) {
+	permissionSQLC, err := r.query.LockPermissionById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "permission.not_found")
+	}
+	return &permissionSQLC, nil
+}
+
+// LockExpiredDeleted читает и блокирует до конца транзакции мягко удаленные записи старше params.Retention
+func (r *permissionRepository) LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedPermissionsParams) ([]generated.Permission, error) {
+	rows, err := r.query.LockExpiredDeletedPermissions(ctx, params)
+	return rows, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error {
+	err := r.query.BulkHardDeletePermissionByIds(ctx, ids)
+	r.events.publishOnSuccess(ctx, err)
+	return translateError(err, "permission.not_found")
+}
+
+// LockByValues читает и блокирует до конца транзакции записи с указанными value, включая мягко удаленные
+func (r *permissionRepository) LockByValues(ctx context.Context, values []string) ([]generated.Permission, error) {
+	rows, err := r.query.LockPermissionsByValues(ctx, values)
+	return rows, translateError(err, "permission.not_found")
+}
+
+// BulkCreate вставляет записи через COPY и возвращает их количество
+func (r *permissionRepository) BulkCreate(ctx context.Context, params []generated.BulkCreatePermissionsParams) (int64, error) {
+	if len(params) == 0 {
+		return 0, nil
+	}
+	count, err := r.query.BulkCreatePermissions(ctx, params)
+	return count, translateError(err, "permission.not_found")
+}
+
+// BulkUpdate обновляет записи одним batch и возвращает обновленные строки
+// Удаленные или отсутствующие записи пропускаются: обновленных строк может быть меньше, чем params
+func (r *permissionRepository) BulkUpdate(ctx context.Context, params []generated.BulkUpdatePermissionsParams) ([]generated.Permission, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	updated := make([]generated.Permission, 0, len(params))
+	var batchErr error
+	r.query.BulkUpdatePermissions(ctx, params).Query(func(_ int, rows []generated.Permission, err error) {
+		if err != nil {
+			if batchErr == nil {
+				batchErr = err
+			}
+			return
+		}
+		updated = append(updated, rows...)
+	})
+	r.events.publishOnSuccess(ctx, batchErr)
+	if batchErr != nil {
+		return nil, translateError(batchErr, "permission.not_found")
+	}
+	return updated, nil
+}
+
+func (r *permissionRepository) ListForExport(ctx context.Context) ([]generated.Permission, error) {
+	rows, err := r.query.ListPermissionsForExport(ctx)
+	return rows, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) List(ctx context.Context, params generated.ListAllPermissionsParams) ([]generated.ListAllPermissionsRow, error) {
+	rows, err := r.query.ListAllPermissions(ctx, params)
+	return rows, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) Paginate(ctx context.Context, params generated.PaginateAllPermissionsParams) ([]generated.PaginateAllPermissionsRow, error) {
+	rows, err := r.query.PaginateAllPermissions(ctx, params)
+	return rows, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) Count(ctx context.Context, params generated.CountAllPermissionsParams) (int64, error) {
+	count, err := r.query.CountAllPermissions(ctx, params)
+	return count, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) SeekAfter(ctx context.Context, params generated.SeekPermissionsAfterParams) ([]generated.SeekPermissionsAfterRow, error) {
+	rows, err := r.query.SeekPermissionsAfter(ctx, params)
+	return rows, translateError(err, "permission.not_found")
+}
+
+func (r *permissionRepository) SeekBefore(ctx context.Context, params generated.SeekPermissionsBeforeParams) ([]generated.SeekPermissionsBeforeRow, error) {
+	rows, err := r.query.SeekPermissionsBefore(ctx, params)
+	return rows, translateError(err, "permission.not_found")
+}
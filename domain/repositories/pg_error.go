@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Коды ошибок PostgreSQL, которые переводятся в доменные ошибки
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgForeignKeyViolation       = "23503"
+	pgUniqueViolation           = "23505"
+	pgCheckViolation            = "23514"
+	pgInvalidTextRepresentation = "22P02"
+	pgStringDataRightTruncation = "22001"
+)
+
+// constraintMessages сопоставляет ограничения схемы с i18n ключами сообщений
+var constraintMessages = map[string]string{
+	"roles_value_key":                "role.value_taken",
+	"permissions_value_key":          "permission.value_taken",
+	"uq_role_permission":             "role_permission.already_assigned",
+	"fk_role_permissions_role":       "role.not_found",
+	"fk_role_permissions_permission": "permission.not_found",
+	"users_email_key":                "user.email_taken",
+	"uq_user_role":                   "user_role.already_assigned",
+	"fk_user_roles_user":             "user.not_found",
+	"fk_user_roles_role":             "role.not_found",
+}
+
+// translateError переводит ошибки pgx/pgconn в доменные ошибки
+// notFoundID - i18n ключ сообщения для pgx.ErrNoRows (например, "role.not_found")
+func translateError(err error, notFoundID string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := domain_error.As(err); ok {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain_error.NotFound(notFoundID).WithCause(err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return domain_error.Internal(err)
+	}
+
+	messageID := constraintMessages[pgErr.ConstraintName]
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return domain_error.Conflict(messageID).WithCause(err)
+	case pgForeignKeyViolation:
+		return domain_error.NotFound(messageID).WithCause(err)
+	case pgCheckViolation, pgInvalidTextRepresentation, pgStringDataRightTruncation:
+		return domain_error.Validation(messageID).WithCause(err)
+	default:
+		return domain_error.Internal(err)
+	}
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db"
+	"context"
+	"sync"
+)
+
+// RolePermissionEvents оповещает подписчиков (кэш разрешений) об изменении прав ролей в этом процессе:
+// связей ролей и разрешений, значений и мягкого удаления ролей и разрешений
+// Другие экземпляры приложения узнают об изменениях через NOTIFY role_permissions_changed
+type RolePermissionEvents struct {
+	mu        sync.RWMutex
+	listeners []func()
+}
+
+func NewRolePermissionEvents() *RolePermissionEvents {
+	return &RolePermissionEvents{}
+}
+
+// Subscribe регистрирует обработчик изменений
+func (e *RolePermissionEvents) Subscribe(listener func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.listeners = append(e.listeners, listener)
+}
+
+// Publish вызывает все обработчики; вызывается репозиториями после успешной записи
+func (e *RolePermissionEvents) Publish() {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for _, listener := range e.listeners {
+		listener()
+	}
+}
+
+// publish оповещает подписчиков после фиксации транзакции из ctx (db.AfterCommit), вне транзакции - сразу
+// Иначе кэш может перечитать права до фиксации и сохранить старые данные
+func (e *RolePermissionEvents) publish(ctx context.Context) {
+	db.AfterCommit(ctx, e.Publish)
+}
+
+// publishOnSuccess оповещает подписчиков, если запись завершилась без ошибки
+func (e *RolePermissionEvents) publishOnSuccess(ctx context.Context, err error) {
+	if err == nil {
+		e.publish(ctx)
+	}
+}
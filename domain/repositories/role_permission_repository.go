@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type RolePermissionRepository interface {
+	GetRolePermissions(ctx context.Context, roleID pgtype.UUID) ([]generated.Permission, error)
+	GetPermissionRoles(ctx context.Context, permissionID pgtype.UUID) ([]generated.Role, error)
+	AssignPermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.RolePermission, error)
+	RemovePermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.RolePermission, error)
+	ReplacePermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.Permission, error)
+	HasPermission(ctx context.Context, roleValue string, permissionValue string) (bool, error)
+	ListAll(ctx context.Context, params generated.ListAllRolePermissionsParams) ([]generated.ListAllRolePermissionsRow, error)
+	Paginate(ctx context.Context, params generated.PaginateAllRolePermissionsParams) ([]generated.PaginateAllRolePermissionsRow, error)
+	Count(ctx context.Context, params generated.CountAllRolePermissionsParams) (int64, error)
+	SeekAfter(ctx context.Context, params generated.SeekRolePermissionsAfterParams) ([]generated.SeekRolePermissionsAfterRow, error)
+	SeekBefore(ctx context.Context, params generated.SeekRolePermissionsBeforeParams) ([]generated.SeekRolePermissionsBeforeRow, error)
+}
+
+type rolePermissionRepository struct {
+	tx     *db.TxManager
+	query  *generated.Queries
+	events *RolePermissionEvents
+}
+
+func NewRolePermissionRepository(tx *db.TxManager, query *generated.Queries, events *RolePermissionEvents) RolePermissionRepository {
+	return &rolePermissionRepository{tx: tx, query: query, events: events}
+}
+
+func (r *rolePermissionRepository) GetRolePermissions(ctx context.Context, roleID pgtype.UUID) ([]generated.Permission, error) {
+	rows, err := r.query.GetRolePermissions(ctx, roleID)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) GetPermissionRoles(ctx context.Context, permissionID pgtype.UUID) ([]generated.Role, error) {
+	rows, err := r.query.GetPermissionRoles(ctx, permissionID)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) AssignPermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.RolePermission, error) {
+	rows, err := r.query.BulkAssignPermissionsToRole(ctx, generated.BulkAssignPermissionsToRoleParams{
+		RoleID:  roleID,
+		Column2: permissionIDs,
+	})
+	r.events.publishOnSuccess(ctx, err)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) RemovePermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.RolePermission, error) {
+	rows, err := r.query.BulkRemovePermissionsFromRole(ctx, generated.BulkRemovePermissionsFromRoleParams{
+		RoleID:  roleID,
+		Column2: permissionIDs,
+	})
+	r.events.publishOnSuccess(ctx, err)
+	return rows, translateError(err, "")
+}
+
+// ReplacePermissions заменяет весь набор разрешений роли в одной транзакции
+// Если любой из шагов завершится ошибкой, роль сохранит прежний набор разрешений
+func (r *rolePermissionRepository) ReplacePermissions(ctx context.Context, roleID pgtype.UUID, permissionIDs []pgtype.UUID) ([]generated.Permission, error) {
+	var permissions []generated.Permission
+
+	err := r.tx.Do(ctx, func(ctx context.Context) error {
+		q := r.query
+
+		if _, err := q.RemoveAllPermissionsFromRole(ctx, roleID); err != nil {
+			return err
+		}
+
+		if _, err := q.BulkAssignPermissionsToRole(ctx, generated.BulkAssignPermissionsToRoleParams{
+			RoleID:  roleID,
+			Column2: permissionIDs,
+		}); err != nil {
+			return err
+		}
+
+		result, err := q.GetRolePermissions(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		permissions = result
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, "")
+	}
+	r.events.publish(ctx)
+
+	return permissions, nil
+}
+
+func (r *rolePermissionRepository) HasPermission(ctx context.Context, roleValue string, permissionValue string) (bool, error) {
+	has, err := r.query.CheckRoleHasPermissionByValue(ctx, generated.CheckRoleHasPermissionByValueParams{
+		Value:   roleValue,
+		Value_2: permissionValue,
+	})
+	return has, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) ListAll(ctx context.Context, params generated.ListAllRolePermissionsParams) ([]generated.ListAllRolePermissionsRow, error) {
+	rows, err := r.query.ListAllRolePermissions(ctx, params)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) Paginate(ctx context.Context, params generated.PaginateAllRolePermissionsParams) ([]generated.PaginateAllRolePermissionsRow, error) {
+	rows, err := r.query.PaginateAllRolePermissions(ctx, params)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) Count(ctx context.Context, params generated.CountAllRolePermissionsParams) (int64, error) {
+	count, err := r.query.CountAllRolePermissions(ctx, params)
+	return count, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) SeekAfter(ctx context.Context, params generated.SeekRolePermissionsAfterParams) ([]generated.SeekRolePermissionsAfterRow, error) {
+	rows, err := r.query.SeekRolePermissionsAfter(ctx, params)
+	return rows, translateError(err, "")
+}
+
+func (r *rolePermissionRepository) SeekBefore(ctx context.Context, params generated.SeekRolePermissionsBeforeParams) ([]generated.SeekRolePermissionsBeforeRow, error) {
+	rows, err := r.query.SeekRolePermissionsBefore(ctx, params)
+	return rows, translateError(err, "")
+}
@@ -3,24 +3,187 @@ package repositories
 import (
 	"clean_architecture_fiber/data/db/generated"
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type RoleRepository interface {
 	GetByValue(ctx context.Context, value string) (*generated.GetRoleByValueRow, error)
+	GetById(ctx context.Context, id pgtype.UUID) (*generated.GetRoleByIdRow, error)
+	Create(ctx context.Context, params generated.CreateOneRoleParams) (*generated.Role, error)
+	UpdateIfUnmodified(ctx context.Context, params generated.UpdateRoleByIdIfUnmodifiedParams) (*generated.Role, error)
+	Delete(ctx context.Context, id pgtype.UUID) (*generated.Role, error)
+	Restore(ctx context.Context, id pgtype.UUID) (*generated.Role, error)
+	HardDelete(ctx context.Context, id pgtype.UUID) error
+	Lock(ctx context.Context, id pgtype.UUID) (*generated.Role, error)
+	LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedRolesParams) ([]generated.Role, error)
+	BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error
+	LockByValues(ctx context.Context, values []string) ([]generated.Role, error)
+	BulkCreate(ctx context.Context, params []generated.BulkCreateRolesParams) (int64, error)
+	BulkUpdate(ctx context.Context, params []generated.BulkUpdateRolesParams) ([]generated.Role, error)
+	ListForExport(ctx context.Context) ([]generated.Role, error)
+	List(ctx context.Context, params generated.ListAllRolesParams) ([]generated.ListAllRolesRow, error)
+	Paginate(ctx context.Context, params generated.PaginateAllRolesParams) ([]generated.PaginateAllRolesRow, error)
+	Count(ctx context.Context, params generated.CountAllRolesParams) (int64, error)
+	SeekAfter(ctx context.Context, params generated.SeekRolesAfterParams) ([]generated.SeekRolesAfterRow, error)
+	SeekBefore(ctx context.Context, params generated.SeekRolesBeforeParams) ([]generated.SeekRolesBeforeRow, error)
 }
 
 type roleRepository struct {
-	query *generated.Queries
+	query  *generated.Queries
+	events *RolePermissionEvents
 }
 
-func NewRoleRepository(query *generated.Queries) RoleRepository {
-	return &roleRepository{query: query}
+func NewRoleRepository(query *generated.Queries, events *RolePermissionEvents) RoleRepository {
+	return &roleRepository{query: query, events: events}
 }
 
 func (r *roleRepository) GetByValue(ctx context.Context, value string) (*generated.GetRoleByValueRow, error) {
 	roleSQLC, err := r.query.GetRoleByValue(ctx, value)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, "role.not_found")
+	}
+	return &roleSQLC, nil
+}
+
+func (r *roleRepository) GetById(ctx context.Context, id pgtype.UUID) (*generated.GetRoleByIdRow, error) {
+	roleSQLC, err := r.query.GetRoleById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
+	}
+	return &roleSQLC, nil
+}
+
+func (r *roleRepository) Create(ctx context.Context, params generated.CreateOneRoleParams) (*generated.Role, error) {
+	roleSQLC, err := r.query.CreateOneRole(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
+	}
+	return &roleSQLC, nil
+}
+
+// UpdateIfUnmodified обновляет строку, только если ее updated_at равен params.UpdatedAt
+// Иначе (строку изменили, удалили или ее нет) возвращает NotFound
+func (r *roleRepository) UpdateIfUnmodified(ctx context.Context, params generated.UpdateRoleByIdIfUnmodifiedParams) (*generated.Role, error) {
+	roleSQLC, err := r.query.UpdateRoleByIdIfUnmodified(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
+	}
+	r.events.publish(ctx)
+	return &roleSQLC, nil
+}
+
+func (r *roleRepository) Delete(ctx context.Context, id pgtype.UUID) (*generated.Role, error) {
+	roleSQLC, err := r.query.DeleteRoleById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
+	}
+	r.events.publish(ctx)
+	return &roleSQLC, nil
+}
+
+func (r *roleRepository) Restore(ctx context.Context, id pgtype.UUID) (*generated.Role, error) {
+	roleSQLC, err := r.query.RestoreRoleById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
+	}
+	r.events.publish(ctx)
+	return &roleSQLC, nil
+}
+
+func (r *roleRepository) HardDelete(ctx context.Context, id pgtype.UUID) error {
+	err := r.query.HardDeleteRoleById(ctx, id)
+	r.events.publishOnSuccess(ctx, err)
+	return translateError(err, "role.not_found")
+}
+
+// Lock читает строку (в том числе мягко удаленную) и блокирует ее до конца транзакции
+// Вызывается внутри TxManager.Do, чтобы получить состояние "до" изменения
+func (r *roleRepository) Lock(ctx context.Context, id pgtype.UUID) (*generated.Role, error) {
+	roleSQLC, err := r.query.LockRoleById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "role.not_found")
 	}
 	return &roleSQLC, nil
 }
+
+// LockExpiredDeleted читает и блокирует до конца транзакции мягко удаленные записи старше params.Retention
+func (r *roleRepository) LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedRolesParams) ([]generated.Role, error) {
+	rows, err := r.query.LockExpiredDeletedRoles(ctx, params)
+	return rows, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error {
+	err := r.query.BulkHardDeleteRoleByIds(ctx, ids)
+	r.events.publishOnSuccess(ctx, err)
+	return translateError(err, "role.not_found")
+}
+
+// LockByValues читает и блокирует до конца транзакции записи с указанными value, включая мягко удаленные
+func (r *roleRepository) LockByValues(ctx context.Context, values []string) ([]generated.Role, error) {
+	rows, err := r.query.LockRolesByValues(ctx, values)
+	return rows, translateError(err, "role.not_found")
+}
+
+// BulkCreate вставляет записи через COPY и возвращает их количество
+func (r *roleRepository) BulkCreate(ctx context.Context, params []generated.BulkCreateRolesParams) (int64, error) {
+	if len(params) == 0 {
+		return 0, nil
+	}
+	count, err := r.query.BulkCreateRoles(ctx, params)
+	return count, translateError(err, "role.not_found")
+}
+
+// BulkUpdate обновляет записи одним batch и возвращает обновленные строки
+// Удаленные или отсутствующие записи пропускаются: обновленных строк может быть меньше, чем params
+func (r *roleRepository) BulkUpdate(ctx context.Context, params []generated.BulkUpdateRolesParams) ([]generated.Role, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	updated := make([]generated.Role, 0, len(params))
+	var batchErr error
+	r.query.BulkUpdateRoles(ctx, params).Query(func(_ int, rows []generated.Role, err error) {
+		if err != nil {
+			if batchErr == nil {
+				batchErr = err
+			}
+			return
+		}
+		updated = append(updated, rows...)
+	})
+	r.events.publishOnSuccess(ctx, batchErr)
+	if batchErr != nil {
+		return nil, translateError(batchErr, "role.not_found")
+	}
+	return updated, nil
+}
+
+func (r *roleRepository) ListForExport(ctx context.Context) ([]generated.Role, error) {
+	rows, err := r.query.ListRolesForExport(ctx)
+	return rows, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) List(ctx context.Context, params generated.ListAllRolesParams) ([]generated.ListAllRolesRow, error) {
+	rows, err := r.query.ListAllRoles(ctx, params)
+	return rows, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) Paginate(ctx context.Context, params generated.PaginateAllRolesParams) ([]generated.PaginateAllRolesRow, error) {
+	rows, err := r.query.PaginateAllRoles(ctx, params)
+	return rows, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) Count(ctx context.Context, params generated.CountAllRolesParams) (int64, error) {
+	count, err := r.query.CountAllRoles(ctx, params)
+	return count, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) SeekAfter(ctx context.Context, params generated.SeekRolesAfterParams) ([]generated.SeekRolesAfterRow, error) {
+	rows, err := r.query.SeekRolesAfter(ctx, params)
+	return rows, translateError(err, "role.not_found")
+}
+
+func (r *roleRepository) SeekBefore(ctx context.Context, params generated.SeekRolesBeforeParams) ([]generated.SeekRolesBeforeRow, error) {
+	rows, err := r.query.SeekRolesBefore(ctx, params)
+	return rows, translateError(err, "role.not_found")
+}
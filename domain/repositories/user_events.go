@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db"
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UserEvents оповещает подписчиков (кэш пользователей запросов) об изменении пользователя в этом процессе:
+// его ролей, email, активности и удаления
+// Другие экземпляры приложения узнают об изменениях через NOTIFY users_changed
+type UserEvents struct {
+	mu        sync.RWMutex
+	listeners []func(userID pgtype.UUID)
+}
+
+func NewUserEvents() *UserEvents {
+	return &UserEvents{}
+}
+
+// Subscribe регистрирует обработчик изменений
+func (e *UserEvents) Subscribe(listener func(userID pgtype.UUID)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.listeners = append(e.listeners, listener)
+}
+
+// Publish вызывает все обработчики; вызывается репозиториями после успешной записи
+func (e *UserEvents) Publish(userID pgtype.UUID) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for _, listener := range e.listeners {
+		listener(userID)
+	}
+}
+
+// publish оповещает подписчиков после фиксации транзакции из ctx (db.AfterCommit), вне транзакции - сразу
+func (e *UserEvents) publish(ctx context.Context, userID pgtype.UUID) {
+	db.AfterCommit(ctx, func() { e.Publish(userID) })
+}
+
+// publishOnSuccess оповещает подписчиков, если запись завершилась без ошибки
+func (e *UserEvents) publishOnSuccess(ctx context.Context, userID pgtype.UUID, err error) {
+	if err == nil {
+		e.publish(ctx, userID)
+	}
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UserRepository interface {
+	GetByEmail(ctx context.Context, email string) (*generated.User, error)
+	GetById(ctx context.Context, id pgtype.UUID) (*generated.User, error)
+	GetRoleValues(ctx context.Context, userID pgtype.UUID) ([]string, error)
+	Create(ctx context.Context, params generated.CreateOneUserParams) (*generated.User, error)
+	Update(ctx context.Context, params generated.UpdateUserByIdParams) (*generated.User, error)
+	UpdateCredentials(ctx context.Context, params generated.UpdateUserCredentialsByIdParams) (*generated.User, error)
+	Delete(ctx context.Context, id pgtype.UUID) (*generated.User, error)
+	Restore(ctx context.Context, id pgtype.UUID) (*generated.User, error)
+	HardDelete(ctx context.Context, id pgtype.UUID) error
+	Lock(ctx context.Context, id pgtype.UUID) (*generated.User, error)
+	Paginate(ctx context.Context, params generated.PaginateAllUsersParams) ([]generated.PaginateAllUsersRow, error)
+	Count(ctx context.Context, params generated.CountAllUsersParams) (int64, error)
+}
+
+type userRepository struct {
+	query  *generated.Queries
+	events *UserEvents
+}
+
+func NewUserRepository(query *generated.Queries, events *UserEvents) UserRepository {
+	return &userRepository{query: query, events: events}
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*generated.User, error) {
+	userSQLC, err := r.query.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	return &userSQLC, nil
+}
+
+func (r *userRepository) GetById(ctx context.Context, id pgtype.UUID) (*generated.User, error) {
+	userSQLC, err := r.query.GetUserById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	return &userSQLC, nil
+}
+
+func (r *userRepository) GetRoleValues(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	rows, err := r.query.GetUserRoleValues(ctx, userID)
+	return rows, translateError(err, "user.not_found")
+}
+
+func (r *userRepository) Create(ctx context.Context, params generated.CreateOneUserParams) (*generated.User, error) {
+	userSQLC, err := r.query.CreateOneUser(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	return &userSQLC, nil
+}
+
+func (r *userRepository) Update(ctx context.Context, params generated.UpdateUserByIdParams) (*generated.User, error) {
+	userSQLC, err := r.query.UpdateUserById(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	r.events.publish(ctx, params.ID)
+	return &userSQLC, nil
+}
+
+func (r *userRepository) UpdateCredentials(ctx context.Context, params generated.UpdateUserCredentialsByIdParams) (*generated.User, error) {
+	userSQLC, err := r.query.UpdateUserCredentialsById(ctx, params)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	r.events.publish(ctx, params.ID)
+	return &userSQLC, nil
+}
+
+func (r *userRepository) Delete(ctx context.Context, id pgtype.UUID) (*generated.User, error) {
+	userSQLC, err := r.query.DeleteUserById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	r.events.publish(ctx, id)
+	return &userSQLC, nil
+}
+
+func (r *userRepository) Restore(ctx context.Context, id pgtype.UUID) (*generated.User, error) {
+	userSQLC, err := r.query.RestoreUserById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	r.events.publish(ctx, id)
+	return &userSQLC, nil
+}
+
+func (r *userRepository) HardDelete(ctx context.Context, id pgtype.UUID) error {
+	err := r.query.HardDeleteUserById(ctx, id)
+	r.events.publishOnSuccess(ctx, id, err)
+	return translateError(err, "user.not_found")
+}
+
+// Lock читает строку (в том числе мягко удаленную) и блокирует ее до конца транзакции
+// Вызывается внутри TxManager.Do, чтобы получить состояние "до" изменения
+func (r *userRepository) Lock(ctx context.Context, id pgtype.UUID) (*generated.User, error) {
+	userSQLC, err := r.query.LockUserById(ctx, id)
+	if err != nil {
+		return nil, translateError(err, "user.not_found")
+	}
+	return &userSQLC, nil
+}
+
+func (r *userRepository) Paginate(ctx context.Context, params generated.PaginateAllUsersParams) ([]generated.PaginateAllUsersRow, error) {
+	rows, err := r.query.PaginateAllUsers(ctx, params)
+	return rows, translateError(err, "user.not_found")
+}
+
+func (r *userRepository) Count(ctx context.Context, params generated.CountAllUsersParams) (int64, error) {
+	count, err := r.query.CountAllUsers(ctx, params)
+	return count, translateError(err, "user.not_found")
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UserRoleRepository interface {
+	GetUserRoles(ctx context.Context, userID pgtype.UUID) ([]generated.Role, error)
+	AssignRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.UserRole, error)
+	RemoveRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.UserRole, error)
+	ReplaceRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.Role, error)
+	HasPermission(ctx context.Context, userID pgtype.UUID, permissionValue string) (bool, error)
+}
+
+type userRoleRepository struct {
+	tx     *db.TxManager
+	query  *generated.Queries
+	events *UserEvents
+}
+
+func NewUserRoleRepository(tx *db.TxManager, query *generated.Queries, events *UserEvents) UserRoleRepository {
+	return &userRoleRepository{tx: tx, query: query, events: events}
+}
+
+func (r *userRoleRepository) GetUserRoles(ctx context.Context, userID pgtype.UUID) ([]generated.Role, error) {
+	rows, err := r.query.GetUserRoles(ctx, userID)
+	return rows, translateError(err, "")
+}
+
+func (r *userRoleRepository) AssignRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.UserRole, error) {
+	rows, err := r.query.BulkAssignRolesToUser(ctx, generated.BulkAssignRolesToUserParams{
+		UserID:  userID,
+		Column2: roleIDs,
+	})
+	r.events.publishOnSuccess(ctx, userID, err)
+	return rows, translateError(err, "")
+}
+
+func (r *userRoleRepository) RemoveRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.UserRole, error) {
+	rows, err := r.query.BulkRemoveRolesFromUser(ctx, generated.BulkRemoveRolesFromUserParams{
+		UserID:  userID,
+		Column2: roleIDs,
+	})
+	r.events.publishOnSuccess(ctx, userID, err)
+	return rows, translateError(err, "")
+}
+
+// ReplaceRoles заменяет весь набор ролей пользователя в одной транзакции
+// Если любой из шагов завершится ошибкой, пользователь сохранит прежний набор ролей
+func (r *userRoleRepository) ReplaceRoles(ctx context.Context, userID pgtype.UUID, roleIDs []pgtype.UUID) ([]generated.Role, error) {
+	var roles []generated.Role
+
+	err := r.tx.Do(ctx, func(ctx context.Context) error {
+		q := r.query
+
+		if _, err := q.RemoveAllRolesFromUser(ctx, userID); err != nil {
+			return err
+		}
+
+		if _, err := q.BulkAssignRolesToUser(ctx, generated.BulkAssignRolesToUserParams{
+			UserID:  userID,
+			Column2: roleIDs,
+		}); err != nil {
+			return err
+		}
+
+		result, err := q.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		roles = result
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, "")
+	}
+
+	r.events.publish(ctx, userID)
+	return roles, nil
+}
+
+func (r *userRoleRepository) HasPermission(ctx context.Context, userID pgtype.UUID, permissionValue string) (bool, error) {
+	has, err := r.query.CheckUserHasPermissionByValue(ctx, generated.CheckUserHasPermissionByValueParams{
+		UserID: userID,
+		Value:  permissionValue,
+	})
+	return has, translateError(err, "")
+}
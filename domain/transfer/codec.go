@@ -0,0 +1,201 @@
+package transfer
+
+import (
+	"bytes"
+	"clean_architecture_fiber/domain/dto"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// Форматы файлов импорта и экспорта
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Columns - колонки CSV в порядке экспорта; при импорте порядок колонок может быть любым
+var Columns = []string{"value", "title_ru", "title_en", "title_kk", "description_ru", "description_en", "description_kk"}
+
+// utf8BOM - метка порядка байтов, которую добавляют в CSV табличные редакторы
+const utf8BOM = "\ufeff"
+
+// formulaEscape - префикс, с которым табличные редакторы показывают ячейку как текст, а не формулу
+const formulaEscape = "'"
+
+// formulaPrefixes - первые символы, с которых табличные редакторы начинают формулу
+const formulaPrefixes = "=+-@\t\r"
+
+// FormatOf определяет формат по параметру format, а если он не задан - по Content-Type
+// Без format и с Content-Type, отличным от text/csv, используется JSON
+func FormatOf(format string, contentType string) (string, error) {
+	if format != "" {
+		if format != FormatJSON && format != FormatCSV {
+			return "", fmt.Errorf("unsupported format %q, expected %s or %s", format, FormatJSON, FormatCSV)
+		}
+		return format, nil
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == "text/csv" {
+		return FormatCSV, nil
+	}
+	return FormatJSON, nil
+}
+
+// ContentType возвращает Content-Type файла экспорта
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/json"
+}
+
+// Decode разбирает файл импорта
+// JSON - массив объектов с полями Columns, CSV - строка заголовка с именами Columns и строки данных
+// Неизвестные поля и колонки считаются ошибкой, чтобы опечатка не превращалась в пустое значение
+func Decode(format string, body []byte) ([]dto.TransferRecordDTO, error) {
+	if format == FormatCSV {
+		return decodeCSV(body)
+	}
+	return decodeJSON(body)
+}
+
+// Encode сериализует записи для экспорта в формате, который принимает Decode
+func Encode(format string, records []dto.TransferRecordDTO) ([]byte, error) {
+	if format == FormatCSV {
+		return encodeCSV(records)
+	}
+	return json.MarshalIndent(records, "", "  ")
+}
+
+func decodeJSON(body []byte) ([]dto.TransferRecordDTO, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+
+	records := []dto.TransferRecordDTO{}
+	if err := decoder.Decode(&records); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("invalid json: unexpected data after array")
+	}
+	return records, nil
+}
+
+func decodeCSV(body []byte) ([]dto.TransferRecordDTO, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(body, []byte(utf8BOM))))
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return []dto.TransferRecordDTO{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv: %w", err)
+	}
+	columns, err := csvColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []dto.TransferRecordDTO{}
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		var record dto.TransferRecordDTO
+		for i, column := range columns {
+			*recordField(&record, column) = unescapeCell(strings.TrimSpace(fields[i]))
+		}
+		records = append(records, record)
+	}
+}
+
+// csvColumns проверяет строку заголовка: только известные колонки, без повторов
+func csvColumns(header []string) ([]string, error) {
+	columns := make([]string, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if recordField(&dto.TransferRecordDTO{}, name) == nil {
+			return nil, fmt.Errorf("invalid csv: unknown column %q, expected %s", name, strings.Join(Columns, ", "))
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("invalid csv: duplicate column %q", name)
+		}
+		seen[name] = true
+		columns[i] = name
+	}
+	return columns, nil
+}
+
+func encodeCSV(records []dto.TransferRecordDTO) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(Columns); err != nil {
+		return nil, err
+	}
+	row := make([]string, len(Columns))
+	for _, record := range records {
+		for i, column := range Columns {
+			row[i] = escapeCell(*recordField(&record, column))
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// escapeCell защищает ячейку от выполнения как формулы: "=1+2" -> "'=1+2"
+// Значение, которое уже выглядит экранированным ("'=1+2"), тоже получает префикс, чтобы пережить unescapeCell
+func escapeCell(value string) string {
+	if startsFormula(value) || unescapeCell(value) != value {
+		return formulaEscape + value
+	}
+	return value
+}
+
+// unescapeCell снимает префикс, добавленный escapeCell: "'=1+2" -> "=1+2"
+func unescapeCell(value string) string {
+	if rest, ok := strings.CutPrefix(value, formulaEscape); ok && (startsFormula(rest) || unescapeCell(rest) != rest) {
+		return rest
+	}
+	return value
+}
+
+// startsFormula сообщает, что табличный редактор примет значение за формулу
+func startsFormula(value string) bool {
+	return value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0]))
+}
+
+// recordField возвращает поле записи по имени колонки или nil для неизвестной колонки
+func recordField(record *dto.TransferRecordDTO, column string) *string {
+	switch column {
+	case "value":
+		return &record.Value
+	case "title_ru":
+		return &record.TitleRu
+	case "title_en":
+		return &record.TitleEn
+	case "title_kk":
+		return &record.TitleKk
+	case "description_ru":
+		return &record.DescriptionRu
+	case "description_en":
+		return &record.DescriptionEn
+	case "description_kk":
+		return &record.DescriptionKk
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,161 @@
+package transfer
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		body    string
+		want    []dto.TransferRecordDTO
+		wantErr string
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			body:   `[{"value":"admin","title_ru":"Админ","description_ru":"Описание"}]`,
+			want:   []dto.TransferRecordDTO{{Value: "admin", TitleRu: "Админ", DescriptionRu: "Описание"}},
+		},
+		{
+			name:   "json empty array",
+			format: FormatJSON,
+			body:   `[]`,
+			want:   []dto.TransferRecordDTO{},
+		},
+		{
+			name:    "json unknown field",
+			format:  FormatJSON,
+			body:    `[{"value":"admin","titel_ru":"x"}]`,
+			wantErr: "invalid json",
+		},
+		{
+			name:    "json trailing data",
+			format:  FormatJSON,
+			body:    `[] []`,
+			wantErr: "unexpected data after array",
+		},
+		{
+			name:   "csv with bom, any column order and spaces",
+			format: FormatCSV,
+			body:   "\ufeffTitle_Ru, value\nАдмин , admin\n",
+			want:   []dto.TransferRecordDTO{{Value: "admin", TitleRu: "Админ"}},
+		},
+		{
+			name:   "csv header only",
+			format: FormatCSV,
+			body:   "value,title_ru\n",
+			want:   []dto.TransferRecordDTO{},
+		},
+		{
+			name:   "csv empty body",
+			format: FormatCSV,
+			body:   "",
+			want:   []dto.TransferRecordDTO{},
+		},
+		{
+			name:   "csv escaped formula",
+			format: FormatCSV,
+			body:   "value,title_ru\nsum,'=1+2\n",
+			want:   []dto.TransferRecordDTO{{Value: "sum", TitleRu: "=1+2"}},
+		},
+		{
+			name:    "csv unknown column",
+			format:  FormatCSV,
+			body:    "value,title\nadmin,x\n",
+			wantErr: `unknown column "title"`,
+		},
+		{
+			name:    "csv duplicate column",
+			format:  FormatCSV,
+			body:    "value,VALUE\nadmin,admin\n",
+			wantErr: `duplicate column "value"`,
+		},
+		{
+			name:    "csv wrong number of fields",
+			format:  FormatCSV,
+			body:    "value,title_ru\nadmin\n",
+			wantErr: "invalid csv",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.format, []byte(tt.body))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Decode() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	records := []dto.TransferRecordDTO{
+		{Value: "admin", TitleRu: "Админ", TitleEn: "Admin", DescriptionRu: "Строка, с \"кавычками\"\nи переводом строки"},
+		{Value: "formula", TitleRu: "=1+2", TitleEn: "+1", TitleKk: "-note", DescriptionRu: "@cmd", DescriptionEn: "'=1+2", DescriptionKk: "''=x"},
+		{Value: "quote", TitleRu: "'plain", TitleEn: "'", DescriptionRu: "\tTab"},
+	}
+	for _, format := range []string{FormatJSON, FormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			body, err := Encode(format, records)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			got, err := Decode(format, body)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, records) {
+				t.Errorf("round trip = %+v, want %+v", got, records)
+			}
+		})
+	}
+}
+
+func TestEncodeCSVEscapesFormulas(t *testing.T) {
+	body, err := Encode(FormatCSV, []dto.TransferRecordDTO{{Value: "x", TitleRu: "=HYPERLINK(\"http://evil\")", TitleEn: "@SUM(A1)"}})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	for _, cell := range []string{`"'=HYPERLINK(""http://evil"")"`, "'@SUM(A1)"} {
+		if !strings.Contains(string(body), cell) {
+			t.Errorf("Encode() = %q, want cell %s", body, cell)
+		}
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	tests := []struct {
+		format      string
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		{format: "csv", contentType: "application/json", want: FormatCSV},
+		{format: "json", want: FormatJSON},
+		{contentType: "text/csv; charset=utf-8", want: FormatCSV},
+		{contentType: "application/json", want: FormatJSON},
+		{want: FormatJSON},
+		{format: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format+"|"+tt.contentType, func(t *testing.T) {
+			got, err := FormatOf(tt.format, tt.contentType)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("FormatOf() = %q, %v, want %q, error %t", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
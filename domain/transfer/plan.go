@@ -0,0 +1,162 @@
+package transfer
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/validation"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Стратегии импорта для строк, value которых уже есть в БД
+const (
+	StrategyUpsert = "upsert" // обновить существующую запись
+	StrategyCreate = "create" // считать строку ошибкой
+	StrategySkip   = "skip"   // оставить существующую запись без изменений
+)
+
+// Strategies - допустимые значения параметра strategy
+var Strategies = []string{StrategyUpsert, StrategyCreate, StrategySkip}
+
+// Статусы строк в отчете импорта
+const (
+	StatusCreated   = "created"
+	StatusUpdated   = "updated"
+	StatusUnchanged = "unchanged"
+	StatusSkipped   = "skipped"
+)
+
+// MaxRows - наибольшее число строк в одном файле импорта
+const MaxRows = 5000
+
+// Existing - запись в БД с тем же value, что и строка импорта
+type Existing struct {
+	Record  dto.TransferRecordDTO
+	Deleted bool
+}
+
+// StrategyOf возвращает стратегию импорта, по умолчанию upsert
+func StrategyOf(input dto.ImportDTO) string {
+	if input.Strategy == "" {
+		return StrategyUpsert
+	}
+	return input.Strategy
+}
+
+// Validate проверяет параметры и строки импорта без обращения к БД
+// Ошибки всех строк возвращаются одной ошибкой валидации с полями вида rows[3].value
+func Validate(input dto.ImportDTO) error {
+	strategy := StrategyOf(input)
+	if !contains(Strategies, strategy) {
+		return fmt.Errorf("unsupported strategy '%s', allowed: %s", strategy, strings.Join(Strategies, ", "))
+	}
+	if len(input.Rows) == 0 {
+		return validation.Fields(validation.Field("rows", "required", ""))
+	}
+	if len(input.Rows) > MaxRows {
+		return validation.Fields(validation.Field("rows", "max_items", strconv.Itoa(MaxRows)))
+	}
+
+	var fields []domain_error.FieldError
+	if err := validation.Struct(input); err != nil {
+		domainErr, ok := domain_error.As(err)
+		if !ok || domainErr.Kind != domain_error.KindValidation {
+			return err
+		}
+		fields = append(fields, domainErr.Fields...)
+	}
+
+	seen := make(map[string]int, len(input.Rows))
+	for i, row := range input.Rows {
+		if row.Value == "" {
+			continue
+		}
+		if first, ok := seen[row.Value]; ok {
+			fields = append(fields, validation.Field(rowField(i, "value"), "unique", strconv.Itoa(first)))
+			continue
+		}
+		seen[row.Value] = i
+	}
+
+	if len(fields) > 0 {
+		return validation.Fields(fields...)
+	}
+	return nil
+}
+
+// Plan определяет статус каждой строки по записям, уже существующим в БД (ключ - value)
+// Строки, совпадающие с мягко удаленной записью, и при стратегии create - с существующей, считаются ошибками
+func Plan(rows []dto.TransferRecordDTO, existing map[string]Existing, strategy string) ([]string, error) {
+	statuses := make([]string, len(rows))
+	var fields []domain_error.FieldError
+	for i, row := range rows {
+		current, ok := existing[row.Value]
+		switch {
+		case !ok:
+			statuses[i] = StatusCreated
+		case current.Deleted:
+			fields = append(fields, validation.Field(rowField(i, "value"), "deleted", ""))
+		case strategy == StrategyCreate:
+			fields = append(fields, validation.Field(rowField(i, "value"), "exists", ""))
+		case strategy == StrategySkip:
+			statuses[i] = StatusSkipped
+		case current.Record == row:
+			statuses[i] = StatusUnchanged
+		default:
+			statuses[i] = StatusUpdated
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, validation.Fields(fields...)
+	}
+	return statuses, nil
+}
+
+// NewReport собирает отчет импорта по статусам строк
+func NewReport(input dto.ImportDTO, statuses []string) *dto.ImportReportRDTO {
+	report := &dto.ImportReportRDTO{
+		ValidateOnly: input.ValidateOnly,
+		Strategy:     StrategyOf(input),
+		Total:        len(input.Rows),
+		Rows:         make([]dto.ImportRowRDTO, 0, len(input.Rows)),
+	}
+	for i, row := range input.Rows {
+		switch statuses[i] {
+		case StatusCreated:
+			report.Created++
+		case StatusUpdated:
+			report.Updated++
+		case StatusUnchanged:
+			report.Unchanged++
+		case StatusSkipped:
+			report.Skipped++
+		}
+		report.Rows = append(report.Rows, dto.ImportRowRDTO{Row: i, Value: row.Value, Status: statuses[i]})
+	}
+	return report
+}
+
+// Values возвращает value всех строк импорта
+func Values(rows []dto.TransferRecordDTO) []string {
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		values[i] = row.Value
+	}
+	return values
+}
+
+// rowField - путь поля строки в ошибке валидации, как у validator: rows[3].value
+func rowField(row int, field string) string {
+	return fmt.Sprintf("rows[%d].%s", row, field)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package transfer
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fieldRules возвращает ошибки полей в виде "rows[1].value:unique"
+func fieldRules(t *testing.T, err error) []string {
+	t.Helper()
+	domainErr, ok := domain_error.As(err)
+	if !ok || domainErr.Kind != domain_error.KindValidation {
+		t.Fatalf("error = %v, want validation error", err)
+	}
+	rules := make([]string, 0, len(domainErr.Fields))
+	for _, field := range domainErr.Fields {
+		rules = append(rules, field.Field+":"+field.Rule)
+	}
+	return rules
+}
+
+func record(value string) dto.TransferRecordDTO {
+	return dto.TransferRecordDTO{Value: value, TitleRu: "Заголовок " + value, DescriptionRu: "Описание " + value}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     dto.ImportDTO
+		wantRules []string
+		wantErr   string
+	}{
+		{
+			name:  "valid",
+			input: dto.ImportDTO{Rows: []dto.TransferRecordDTO{record("a"), record("b")}},
+		},
+		{
+			name:    "unknown strategy",
+			input:   dto.ImportDTO{Strategy: "merge", Rows: []dto.TransferRecordDTO{record("a")}},
+			wantErr: "unsupported strategy 'merge'",
+		},
+		{
+			name:      "no rows",
+			input:     dto.ImportDTO{Strategy: StrategySkip},
+			wantRules: []string{"rows:required"},
+		},
+		{
+			name:      "too many rows",
+			input:     dto.ImportDTO{Rows: make([]dto.TransferRecordDTO, MaxRows+1)},
+			wantRules: []string{"rows:max_items"},
+		},
+		{
+			name:      "duplicate values",
+			input:     dto.ImportDTO{Rows: []dto.TransferRecordDTO{record("a"), record("b"), record("a")}},
+			wantRules: []string{"rows[2].value:unique"},
+		},
+		{
+			name: "row errors merged with duplicates",
+			input: dto.ImportDTO{Rows: []dto.TransferRecordDTO{
+				record("a"),
+				{Value: "b", DescriptionRu: "Описание"},
+				record("a"),
+			}},
+			wantRules: []string{"rows[1].title_ru:required", "rows[2].value:unique"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.input)
+			switch {
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Validate() error = %v, want containing %q", err, tt.wantErr)
+				}
+			case tt.wantRules != nil:
+				if got := fieldRules(t, err); !reflect.DeepEqual(got, tt.wantRules) {
+					t.Errorf("Validate() fields = %v, want %v", got, tt.wantRules)
+				}
+			case err != nil:
+				t.Fatalf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	changed := record("changed")
+	changed.TitleEn = "New title"
+	rows := []dto.TransferRecordDTO{record("new"), record("same"), changed}
+	existing := map[string]Existing{
+		"same":    {Record: record("same")},
+		"changed": {Record: record("changed")},
+	}
+
+	tests := []struct {
+		name      string
+		rows      []dto.TransferRecordDTO
+		existing  map[string]Existing
+		strategy  string
+		want      []string
+		wantRules []string
+	}{
+		{
+			name:     "upsert",
+			rows:     rows,
+			existing: existing,
+			strategy: StrategyUpsert,
+			want:     []string{StatusCreated, StatusUnchanged, StatusUpdated},
+		},
+		{
+			name:     "skip",
+			rows:     rows,
+			existing: existing,
+			strategy: StrategySkip,
+			want:     []string{StatusCreated, StatusSkipped, StatusSkipped},
+		},
+		{
+			name:      "create rejects existing",
+			rows:      rows,
+			existing:  existing,
+			strategy:  StrategyCreate,
+			wantRules: []string{"rows[1].value:exists", "rows[2].value:exists"},
+		},
+		{
+			name:      "soft deleted is an error for every strategy",
+			rows:      []dto.TransferRecordDTO{record("new"), record("gone")},
+			existing:  map[string]Existing{"gone": {Record: record("gone"), Deleted: true}},
+			strategy:  StrategySkip,
+			wantRules: []string{"rows[1].value:deleted"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Plan(tt.rows, tt.existing, tt.strategy)
+			if tt.wantRules != nil {
+				if gotRules := fieldRules(t, err); !reflect.DeepEqual(gotRules, tt.wantRules) {
+					t.Errorf("Plan() fields = %v, want %v", gotRules, tt.wantRules)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Plan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	input := dto.ImportDTO{ValidateOnly: true, Rows: []dto.TransferRecordDTO{record("a"), record("b"), record("c"), record("d")}}
+	report := NewReport(input, []string{StatusCreated, StatusUpdated, StatusUnchanged, StatusCreated})
+
+	if report.Strategy != StrategyUpsert || !report.ValidateOnly || report.Total != 4 {
+		t.Errorf("NewReport() header = %+v", report)
+	}
+	if report.Created != 2 || report.Updated != 1 || report.Unchanged != 1 || report.Skipped != 0 {
+		t.Errorf("NewReport() counts = created %d, updated %d, unchanged %d, skipped %d",
+			report.Created, report.Updated, report.Unchanged, report.Skipped)
+	}
+	if row := report.Rows[1]; row.Row != 1 || row.Value != "b" || row.Status != StatusUpdated {
+		t.Errorf("NewReport() row 1 = %+v", row)
+	}
+}
@@ -0,0 +1,285 @@
+package trash
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// DefaultInterval - период очистки, если trash.purgeInterval не задан
+	DefaultInterval = time.Hour
+	// DefaultBatchSize - число записей в одной транзакции, если trash.batchSize не задан
+	DefaultBatchSize int32 = 500
+)
+
+// Settings - параметры очистки корзины, читаются перед каждым запуском (применяются на лету)
+type Settings struct {
+	Enabled   bool
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize int32
+}
+
+// Purged - безвозвратно удаленные записи одной таблицы: число, ID и значения (value) в порядке удаления
+type Purged struct {
+	Count  int      `json:"count"`
+	IDs    []string `json:"ids"`
+	Values []string `json:"values"`
+}
+
+func newPurged() Purged {
+	return Purged{IDs: []string{}, Values: []string{}}
+}
+
+// add добавляет удаленную запись
+func (p *Purged) add(id pgtype.UUID, value string) {
+	p.Count++
+	p.IDs = append(p.IDs, uuid.UUID(id.Bytes).String())
+	p.Values = append(p.Values, value)
+}
+
+// merge добавляет удаленную пачку
+func (p *Purged) merge(batch Purged) {
+	p.Count += batch.Count
+	p.IDs = append(p.IDs, batch.IDs...)
+	p.Values = append(p.Values, batch.Values...)
+}
+
+// Таблицы, которые очищает Purger
+const (
+	TableRoles       = "roles"
+	TablePermissions = "permissions"
+)
+
+// Report - безвозвратно удаленные записи по таблицам
+type Report struct {
+	Roles       Purged `json:"roles"`
+	Permissions Purged `json:"permissions"`
+}
+
+// Empty сообщает, что удалять было нечего
+func (r Report) Empty() bool {
+	return r.Roles.Count == 0 && r.Permissions.Count == 0
+}
+
+// Table возвращает удаленные записи таблицы TableRoles или TablePermissions
+func (r Report) Table(name string) (Purged, bool) {
+	switch name {
+	case TableRoles:
+		return r.Roles, true
+	case TablePermissions:
+		return r.Permissions, true
+	}
+	return Purged{}, false
+}
+
+// RunResult - итог запуска Purge: Report содержит удаленное до ошибки Err
+type RunResult struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Retention  time.Duration
+	Report     Report
+	Err        error
+}
+
+// Transactor выполняет fn в транзакции (*db.TxManager)
+type Transactor interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Purger безвозвратно удаляет мягко удаленные роли и разрешения старше retention
+// Каждая пачка удаляется в отдельной транзакции вместе с записями audit_log (hard_delete без автора)
+// Связи role_permissions удаляются каскадно, кэш разрешений сбрасывается событиями репозиториев
+// Итог последнего запуска доступен через LastRun
+type Purger struct {
+	Roles       repositories.RoleRepository
+	Permissions repositories.PermissionRepository
+	Tx          Transactor
+	Audit       *audit.Recorder
+
+	mu   sync.RWMutex
+	last *RunResult
+}
+
+func NewPurger(roles repositories.RoleRepository, permissions repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *Purger {
+	return &Purger{Roles: roles, Permissions: permissions, Tx: tx, Audit: recorder}
+}
+
+// Run запускает очистку с периодом settings().Interval до отмены ctx
+// При settings().Enabled == false запуск пропускается, но цикл продолжает работать
+func (p *Purger) Run(ctx context.Context, settings func() Settings) {
+	for {
+		current := settings()
+		interval := current.Interval
+		if interval <= 0 {
+			interval = DefaultInterval
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		current = settings()
+		if !current.Enabled || current.Retention <= 0 {
+			continue
+		}
+		report, err := p.Purge(ctx, current.Retention, current.BatchSize)
+		if err != nil {
+			log.Printf("⚠️ Trash purge failed: %v", err)
+		}
+		if !report.Empty() {
+			log.Printf("🗑️ Trash purge removed %d roles [%s] and %d permissions [%s] deleted more than %s ago",
+				report.Roles.Count, strings.Join(report.Roles.Values, ", "),
+				report.Permissions.Count, strings.Join(report.Permissions.Values, ", "),
+				current.Retention)
+		}
+	}
+}
+
+// LastRun возвращает итог последнего запуска Purge; false, если очистка еще не запускалась
+func (p *Purger) LastRun() (RunResult, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.last == nil {
+		return RunResult{}, false
+	}
+	return *p.last, true
+}
+
+// Purge удаляет роли и разрешения, мягко удаленные раньше чем retention назад
+// Report содержит удаленное до ошибки, даже если err != nil; итог сохраняется для LastRun
+func (p *Purger) Purge(ctx context.Context, retention time.Duration, batchSize int32) (Report, error) {
+	result := RunResult{StartedAt: time.Now(), Retention: retention}
+	result.Report, result.Err = p.purge(ctx, retention, batchSize)
+	result.FinishedAt = time.Now()
+
+	p.mu.Lock()
+	p.last = &result
+	p.mu.Unlock()
+	return result.Report, result.Err
+}
+
+// purge удаляет пачки ролей, затем разрешений, пока пачка заполнена целиком
+func (p *Purger) purge(ctx context.Context, retention time.Duration, batchSize int32) (Report, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	interval := pgtype.Interval{Microseconds: retention.Microseconds(), Valid: true}
+	report := Report{Roles: newPurged(), Permissions: newPurged()}
+
+	for {
+		purged, err := p.purgeRoles(ctx, interval, batchSize)
+		report.Roles.merge(purged)
+		if err != nil {
+			return report, err
+		}
+		if int32(purged.Count) < batchSize {
+			break
+		}
+	}
+	for {
+		purged, err := p.purgePermissions(ctx, interval, batchSize)
+		report.Permissions.merge(purged)
+		if err != nil {
+			return report, err
+		}
+		if int32(purged.Count) < batchSize {
+			break
+		}
+	}
+	return report, nil
+}
+
+// purgeRoles удаляет одну пачку ролей и возвращает их ID и значения
+func (p *Purger) purgeRoles(ctx context.Context, retention pgtype.Interval, batchSize int32) (Purged, error) {
+	purged := newPurged()
+	err := p.Tx.Do(ctx, func(ctx context.Context) error {
+		roles, err := p.Roles.LockExpiredDeleted(ctx, generated.LockExpiredDeletedRolesParams{
+			Retention: retention,
+			Limit:     batchSize,
+		})
+		if err != nil || len(roles) == 0 {
+			return err
+		}
+
+		ids := make([]pgtype.UUID, len(roles))
+		batch := newPurged()
+		for i, role := range roles {
+			ids[i] = role.ID
+			batch.add(role.ID, role.Value)
+		}
+		if err := p.Roles.BulkHardDelete(ctx, ids); err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if err := p.Audit.RecordSystem(ctx, audit.Entry{
+				Action:   audit.ActionHardDelete,
+				Entity:   audit.EntityRole,
+				EntityID: role.ID,
+				Before:   role,
+			}); err != nil {
+				return err
+			}
+		}
+		purged = batch
+		return nil
+	})
+	if err != nil {
+		return newPurged(), err
+	}
+	return purged, nil
+}
+
+// purgePermissions удаляет одну пачку разрешений и возвращает их ID и значения
+func (p *Purger) purgePermissions(ctx context.Context, retention pgtype.Interval, batchSize int32) (Purged, error) {
+	purged := newPurged()
+	err := p.Tx.Do(ctx, func(ctx context.Context) error {
+		permissions, err := p.Permissions.LockExpiredDeleted(ctx, generated.LockExpiredDeletedPermissionsParams{
+			Retention: retention,
+			Limit:     batchSize,
+		})
+		if err != nil || len(permissions) == 0 {
+			return err
+		}
+
+		ids := make([]pgtype.UUID, len(permissions))
+		batch := newPurged()
+		for i, permission := range permissions {
+			ids[i] = permission.ID
+			batch.add(permission.ID, permission.Value)
+		}
+		if err := p.Permissions.BulkHardDelete(ctx, ids); err != nil {
+			return err
+		}
+		for _, permission := range permissions {
+			if err := p.Audit.RecordSystem(ctx, audit.Entry{
+				Action:   audit.ActionHardDelete,
+				Entity:   audit.EntityPermission,
+				EntityID: permission.ID,
+				Before:   permission,
+			}); err != nil {
+				return err
+			}
+		}
+		purged = batch
+		return nil
+	})
+	if err != nil {
+		return newPurged(), err
+	}
+	return purged, nil
+}
@@ -0,0 +1,157 @@
+package trash
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// fakeTx выполняет fn без транзакции
+type fakeTx struct{}
+
+func (fakeTx) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeRoles - мягко удаленные роли в памяти, LockExpiredDeleted отдает их пачками по Limit
+// Остальные методы RoleRepository в тестах не вызываются
+type fakeRoles struct {
+	repositories.RoleRepository
+	deleted []generated.Role
+}
+
+func (f *fakeRoles) LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedRolesParams) ([]generated.Role, error) {
+	return f.deleted[:min(int(params.Limit), len(f.deleted))], nil
+}
+
+func (f *fakeRoles) BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error {
+	f.deleted = f.deleted[len(ids):]
+	return nil
+}
+
+// fakePermissions - мягко удаленные разрешения в памяти, err возвращается из LockExpiredDeleted
+type fakePermissions struct {
+	repositories.PermissionRepository
+	deleted []generated.Permission
+	err     error
+}
+
+func (f *fakePermissions) LockExpiredDeleted(ctx context.Context, params generated.LockExpiredDeletedPermissionsParams) ([]generated.Permission, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.deleted[:min(int(params.Limit), len(f.deleted))], nil
+}
+
+func (f *fakePermissions) BulkHardDelete(ctx context.Context, ids []pgtype.UUID) error {
+	f.deleted = f.deleted[len(ids):]
+	return nil
+}
+
+// fakeAuditLog считает записи журнала
+type fakeAuditLog struct {
+	repositories.AuditLogRepository
+	entries int
+}
+
+func (f *fakeAuditLog) Create(ctx context.Context, params generated.CreateAuditLogParams) (*generated.AuditLog, error) {
+	f.entries++
+	return &generated.AuditLog{}, nil
+}
+
+func newID() pgtype.UUID {
+	return pgtype.UUID{Bytes: uuid.New(), Valid: true}
+}
+
+func TestPurgeReport(t *testing.T) {
+	roleIDs := []pgtype.UUID{newID(), newID(), newID()}
+	permissionID := newID()
+	failure := errors.New("connection lost")
+
+	tests := []struct {
+		name            string
+		permissionsErr  error
+		wantRoles       Purged
+		wantPermissions Purged
+		wantAudit       int
+	}{
+		{
+			name: "all tables",
+			wantRoles: Purged{
+				Count:  3,
+				IDs:    []string{uuid.UUID(roleIDs[0].Bytes).String(), uuid.UUID(roleIDs[1].Bytes).String(), uuid.UUID(roleIDs[2].Bytes).String()},
+				Values: []string{"a", "b", "c"},
+			},
+			wantPermissions: Purged{
+				Count:  1,
+				IDs:    []string{uuid.UUID(permissionID.Bytes).String()},
+				Values: []string{"read"},
+			},
+			wantAudit: 4,
+		},
+		{
+			name:           "failure keeps what was removed",
+			permissionsErr: failure,
+			wantRoles: Purged{
+				Count:  3,
+				IDs:    []string{uuid.UUID(roleIDs[0].Bytes).String(), uuid.UUID(roleIDs[1].Bytes).String(), uuid.UUID(roleIDs[2].Bytes).String()},
+				Values: []string{"a", "b", "c"},
+			},
+			wantPermissions: Purged{IDs: []string{}, Values: []string{}},
+			wantAudit:       3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roles := &fakeRoles{deleted: []generated.Role{
+				{ID: roleIDs[0], Value: "a"},
+				{ID: roleIDs[1], Value: "b"},
+				{ID: roleIDs[2], Value: "c"},
+			}}
+			permissions := &fakePermissions{deleted: []generated.Permission{{ID: permissionID, Value: "read"}}, err: tt.permissionsErr}
+			auditLog := &fakeAuditLog{}
+			purger := &Purger{Roles: roles, Permissions: permissions, Tx: fakeTx{}, Audit: audit.NewRecorder(auditLog)}
+
+			if _, ok := purger.LastRun(); ok {
+				t.Fatalf("LastRun() before Purge reported a run")
+			}
+			// Пачка из двух записей: роли удаляются за две транзакции
+			report, err := purger.Purge(context.Background(), time.Hour, 2)
+			if !errors.Is(err, tt.permissionsErr) {
+				t.Fatalf("Purge() error = %v, want %v", err, tt.permissionsErr)
+			}
+
+			if !reflect.DeepEqual(report.Roles, tt.wantRoles) {
+				t.Errorf("report.Roles = %+v, want %+v", report.Roles, tt.wantRoles)
+			}
+			if !reflect.DeepEqual(report.Permissions, tt.wantPermissions) {
+				t.Errorf("report.Permissions = %+v, want %+v", report.Permissions, tt.wantPermissions)
+			}
+			if auditLog.entries != tt.wantAudit {
+				t.Errorf("audit entries = %d, want %d", auditLog.entries, tt.wantAudit)
+			}
+
+			last, ok := purger.LastRun()
+			if !ok {
+				t.Fatalf("LastRun() after Purge reported no run")
+			}
+			if !reflect.DeepEqual(last.Report, report) || !errors.Is(last.Err, tt.permissionsErr) || last.Retention != time.Hour {
+				t.Errorf("LastRun() = %+v, want report %+v, error %v", last, report, tt.permissionsErr)
+			}
+			if last.FinishedAt.Before(last.StartedAt) {
+				t.Errorf("LastRun() finished at %s before start %s", last.FinishedAt, last.StartedAt)
+			}
+			if purged, ok := last.Report.Table(TableRoles); !ok || purged.Count != tt.wantRoles.Count {
+				t.Errorf("Report.Table(%q) = %+v, %t", TableRoles, purged, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package audit_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AuditLogPaginationOptions - допустимые параметры журнала изменений
+// search ищет по email автора, сортировка только по времени изменения
+var AuditLogPaginationOptions = pagination.Options{
+	SortFields:    []string{"created_at"},
+	DefaultSortBy: "created_at",
+	Searchable:    true,
+	Filters:       []string{"actor_ids", "actions", "entities", "entity_ids", "request_ids"},
+	UUIDFilters:   []string{"actor_ids", "entity_ids"},
+}
+
+// PaginateAuditLogInput - параметры списка и период [from, to) в формате RFC 3339
+type PaginateAuditLogInput struct {
+	pagination.Query
+	From string
+	To   string
+}
+
+type PaginateAuditLogUseCase struct {
+	Repo repositories.AuditLogRepository
+}
+
+func NewPaginateAuditLogUseCase(repo repositories.AuditLogRepository) *PaginateAuditLogUseCase {
+	return &PaginateAuditLogUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *PaginateAuditLogUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input PaginateAuditLogInput) error {
+	if input.IsCursorMode() {
+		return fmt.Errorf("cursor mode is not supported")
+	}
+	if err := input.Validate(AuditLogPaginationOptions); err != nil {
+		return err
+	}
+	if err := validateValues("actions", input.Strings("actions"), audit.Actions); err != nil {
+		return err
+	}
+	if err := validateValues("entities", input.Strings("entities"), audit.Entities); err != nil {
+		return err
+	}
+	from, err := parseTime("from", input.From)
+	if err != nil {
+		return err
+	}
+	to, err := parseTime("to", input.To)
+	if err != nil {
+		return err
+	}
+	if from.Valid && to.Valid && !from.Time.Before(to.Time) {
+		return fmt.Errorf("from must be earlier than to")
+	}
+	return nil
+}
+
+func (u *PaginateAuditLogUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input PaginateAuditLogInput) (*pagination.Page[dto.AuditLogRDTO], error) {
+	// Формат проверен в Validate
+	from, _ := parseTime("from", input.From)
+	to, _ := parseTime("to", input.To)
+
+	auditLogsSQLC, total, err := pagination.Fetch(ctx,
+		func(ctx context.Context) ([]generated.AuditLog, error) {
+			return u.Repo.Paginate(ctx, generated.PaginateAuditLogsParams{
+				ActorIds:    input.UUIDs("actor_ids"),
+				Actions:     input.Strings("actions"),
+				EntityTypes: input.Strings("entities"),
+				EntityIds:   input.UUIDs("entity_ids"),
+				RequestIds:  input.Strings("request_ids"),
+				Search:      input.SearchText(),
+				From:        from,
+				To:          to,
+				SortOrder:   input.SortOrder,
+				Offset:      input.Offset(),
+				Limit:       input.Limit(),
+			})
+		},
+		func(ctx context.Context) (int64, error) {
+			return u.Repo.Count(ctx, generated.CountAuditLogsParams{
+				ActorIds:    input.UUIDs("actor_ids"),
+				Actions:     input.Strings("actions"),
+				EntityTypes: input.Strings("entities"),
+				EntityIds:   input.UUIDs("entity_ids"),
+				RequestIds:  input.Strings("request_ids"),
+				Search:      input.SearchText(),
+				From:        from,
+				To:          to,
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(mapper.AuditLogRDTOListFromAuditLogsSQLC(auditLogsSQLC), input.Query, total), nil
+}
+
+func (u *PaginateAuditLogUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.Page[dto.AuditLogRDTO]) (any, error) {
+	return result, nil
+}
+
+// validateValues проверяет, что значения фильтра входят в список допустимых
+func validateValues(name string, values, allowed []string) error {
+	for _, value := range values {
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("filter '%s' contains unsupported value '%s', allowed: %s", name, value, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// parseTime разбирает границу периода; пустое значение - граница не задана (NULL)
+// Время без часового пояса не принимается; граница переводится в UTC (как now() при database.timeZone=UTC)
+func parseTime(name, value string) (pgtype.Timestamp, error) {
+	if value == "" {
+		return pgtype.Timestamp{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return pgtype.Timestamp{}, fmt.Errorf("%s must be an RFC 3339 timestamp, e.g. 2024-01-31T00:00:00Z", name)
+	}
+	return pgtype.Timestamp{Time: parsed.UTC(), Valid: true}, nil
+}
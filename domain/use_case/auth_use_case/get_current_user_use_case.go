@@ -0,0 +1,57 @@
+package auth_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetCurrentUserInput не содержит полей: пользователь берется из контекста запроса
+type GetCurrentUserInput struct{}
+
+// GetCurrentUserUseCase возвращает профиль аутентифицированного пользователя
+type GetCurrentUserUseCase struct {
+	Repo repositories.UserRepository
+}
+
+func NewGetCurrentUserUseCase(repo repositories.UserRepository) *GetCurrentUserUseCase {
+	return &GetCurrentUserUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetCurrentUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetCurrentUserInput) error {
+	if _, ok := auth.GetPrincipal(fiberCtx); !ok {
+		return domain_error.Unauthorized("")
+	}
+	return nil
+}
+
+func (u *GetCurrentUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetCurrentUserInput) (*dto.UserRDTO, error) {
+	principal, _ := auth.GetPrincipal(fiberCtx)
+	userID, err := mapper.ParseUUID(principal.UserID)
+	if err != nil {
+		return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+	}
+
+	userSQLC, err := u.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := u.Repo.GetRoleValues(ctx, userSQLC.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mapper.UserRDTOFromUserSQLC(*userSQLC, roles)
+	return &result, nil
+}
+
+func (u *GetCurrentUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.UserRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package auth_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LoginUseCase проверяет email и пароль и выпускает пару токенов
+type LoginUseCase struct {
+	Repo   repositories.UserRepository
+	Tokens *auth.TokenManager
+}
+
+func NewLoginUseCase(repo repositories.UserRepository, tokens *auth.TokenManager) *LoginUseCase {
+	return &LoginUseCase{Repo: repo, Tokens: tokens}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *LoginUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.LoginDTO) error {
+	return validation.Struct(input)
+}
+
+func (u *LoginUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.LoginDTO) (*auth.TokenPair, error) {
+	userSQLC, err := u.Repo.GetByEmail(ctx, mapper.NormalizeEmail(input.Email))
+	if domain_error.Is(err, domain_error.KindNotFound) {
+		// Неизвестный email и неверный пароль неотличимы ни по ответу, ни по времени
+		auth.WastePasswordCheck(input.Password)
+		return nil, domain_error.Unauthorized("auth.invalid_credentials")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !auth.CheckPassword(userSQLC.PasswordHash, input.Password) {
+		return nil, domain_error.Unauthorized("auth.invalid_credentials")
+	}
+	if !userSQLC.IsActive {
+		return nil, domain_error.Forbidden("auth.user_inactive")
+	}
+
+	return u.Tokens.IssuePair(mapper.SubjectFromUserSQLC(*userSQLC))
+}
+
+func (u *LoginUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *auth.TokenPair) (any, error) {
+	return mapper.TokenPairRDTOFromTokenPair(result), nil
+}
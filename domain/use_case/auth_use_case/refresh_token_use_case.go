@@ -0,0 +1,59 @@
+package auth_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RefreshTokenUseCase выпускает новую пару токенов по действующему refresh токену
+// Пользователь перечитывается из БД: заблокированный или удаленный пользователь новую пару не получит
+type RefreshTokenUseCase struct {
+	Repo   repositories.UserRepository
+	Tokens *auth.TokenManager
+}
+
+func NewRefreshTokenUseCase(repo repositories.UserRepository, tokens *auth.TokenManager) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{Repo: repo, Tokens: tokens}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *RefreshTokenUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.RefreshTokenDTO) error {
+	return validation.Struct(input)
+}
+
+func (u *RefreshTokenUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.RefreshTokenDTO) (*auth.TokenPair, error) {
+	subject, err := u.Tokens.ParseRefreshToken(input.RefreshToken)
+	if err != nil {
+		return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+	}
+
+	userID, err := mapper.ParseUUID(subject)
+	if err != nil {
+		return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+	}
+
+	userSQLC, err := u.Repo.GetById(ctx, userID)
+	if domain_error.Is(err, domain_error.KindNotFound) {
+		return nil, domain_error.Unauthorized("auth.invalid_token").WithCause(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !userSQLC.IsActive {
+		return nil, domain_error.Forbidden("auth.user_inactive")
+	}
+
+	return u.Tokens.IssuePair(mapper.SubjectFromUserSQLC(*userSQLC))
+}
+
+func (u *RefreshTokenUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *auth.TokenPair) (any, error) {
+	return mapper.TokenPairRDTOFromTokenPair(result), nil
+}
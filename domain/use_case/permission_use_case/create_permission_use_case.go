@@ -0,0 +1,54 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreatePermissionUseCase struct {
+	Repo  repositories.PermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewCreatePermissionUseCase(repo repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *CreatePermissionUseCase {
+	return &CreatePermissionUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *CreatePermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.PermissionDTO) error {
+	return validation.Struct(input)
+}
+
+func (u *CreatePermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.PermissionDTO) (*dto.PermissionRDTO, error) {
+	var permissionSQLC *generated.Permission
+	err := u.Tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if permissionSQLC, err = u.Repo.Create(ctx, mapper.CreateOnePermissionParamsFromPermissionDTO(input)); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionCreate,
+			Entity:   audit.EntityPermission,
+			EntityID: permissionSQLC.ID,
+			After:    permissionSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := mapper.PermissionRDTOFromPermissionSQLC(fiberCtx, *permissionSQLC)
+	return &result, nil
+}
+
+func (u *CreatePermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
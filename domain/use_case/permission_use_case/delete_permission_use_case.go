@@ -0,0 +1,67 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeletePermissionInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+// DeletePermissionUseCase выполняет мягкое удаление разрешения (заполняет deleted_at)
+type DeletePermissionUseCase struct {
+	Repo  repositories.PermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewDeletePermissionUseCase(repo repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *DeletePermissionUseCase {
+	return &DeletePermissionUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *DeletePermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input DeletePermissionInput) error {
+	return validation.Struct(input)
+}
+
+func (u *DeletePermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input DeletePermissionInput) (*dto.PermissionRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	var permissionSQLC *generated.Permission
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if permissionSQLC, err = u.Repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionDelete,
+			Entity:   audit.EntityPermission,
+			EntityID: id,
+			Before:   before,
+			After:    permissionSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := mapper.PermissionRDTOFromPermissionSQLC(fiberCtx, *permissionSQLC)
+	return &result, nil
+}
+
+func (u *DeletePermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
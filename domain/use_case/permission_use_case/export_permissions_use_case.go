@@ -0,0 +1,48 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/transfer"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportPermissionsUseCase выгружает все неудаленные разрешения в формате, который принимает ImportPermissionsUseCase
+type ExportPermissionsUseCase struct {
+	Repo repositories.PermissionRepository
+}
+
+func NewExportPermissionsUseCase(repo repositories.PermissionRepository) *ExportPermissionsUseCase {
+	return &ExportPermissionsUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ExportPermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ExportDTO) error {
+	_, err := transfer.FormatOf(input.Format, "")
+	return err
+}
+
+func (u *ExportPermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ExportDTO) (*dto.FileRDTO, error) {
+	permissionsSQLC, err := u.Repo.ListForExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	format, _ := transfer.FormatOf(input.Format, "")
+	content, err := transfer.Encode(format, mapper.TransferRecordListFromPermissionsSQLC(permissionsSQLC))
+	if err != nil {
+		return nil, err
+	}
+	return &dto.FileRDTO{
+		Name:        "permissions." + format,
+		ContentType: transfer.ContentType(format),
+		Content:     content,
+	}, nil
+}
+
+func (u *ExportPermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.FileRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetPermissionByIdInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+type GetPermissionByIdUseCase struct {
+	Repo repositories.PermissionRepository
+}
+
+func NewGetPermissionByIdUseCase(repo repositories.PermissionRepository) *GetPermissionByIdUseCase {
+	return &GetPermissionByIdUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetPermissionByIdUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionByIdInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetPermissionByIdUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionByIdInput) (*dto.PermissionRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	permissionSQLC, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if permissionSQLC == nil {
+		return nil, domain_error.NotFound("permission.not_found")
+	}
+	result := mapper.PermissionRDTOFromPermissionByIdSQLC(fiberCtx, *permissionSQLC)
+	return &result, nil
+}
+
+func (u *GetPermissionByIdUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
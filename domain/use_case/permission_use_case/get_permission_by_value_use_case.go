@@ -0,0 +1,45 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetPermissionByValueInput struct {
+	Value string `params:"value" validate:"required"`
+}
+
+type GetPermissionByValueUseCase struct {
+	Repo repositories.PermissionRepository
+}
+
+func NewGetPermissionByValueUseCase(repo repositories.PermissionRepository) *GetPermissionByValueUseCase {
+	return &GetPermissionByValueUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetPermissionByValueUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionByValueInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetPermissionByValueUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionByValueInput) (*dto.PermissionRDTO, error) {
+	permissionSQLC, err := u.Repo.GetByValue(ctx, input.Value)
+	if err != nil {
+		return nil, err
+	}
+	if permissionSQLC == nil {
+		return nil, domain_error.NotFound("permission.not_found")
+	}
+	result := mapper.PermissionRDTOFromPermissionByValueSQLC(fiberCtx, *permissionSQLC)
+	return &result, nil
+}
+
+func (u *GetPermissionByValueUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HardDeletePermissionInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+// HardDeletePermissionUseCase безвозвратно удаляет разрешение
+// Связи role_permissions удаляются каскадно (ON DELETE CASCADE)
+type HardDeletePermissionUseCase struct {
+	Repo  repositories.PermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewHardDeletePermissionUseCase(repo repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *HardDeletePermissionUseCase {
+	return &HardDeletePermissionUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *HardDeletePermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input HardDeletePermissionInput) error {
+	return validation.Struct(input)
+}
+
+func (u *HardDeletePermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input HardDeletePermissionInput) (bool, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return false, err
+	}
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if domain_error.Is(err, domain_error.KindNotFound) {
+			// Разрешения уже нет: удаление идемпотентно, в журнал записывать нечего
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := u.Repo.HardDelete(ctx, id); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionHardDelete,
+			Entity:   audit.EntityPermission,
+			EntityID: id,
+			Before:   before,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *HardDeletePermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result bool) (any, error) {
+	return fiber.Map{"deleted": result}, nil
+}
@@ -0,0 +1,116 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/transfer"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ImportPermissionsUseCase создает и обновляет разрешения из файла, сопоставляя их по value
+// Импорт выполняется целиком в одной транзакции: при ошибке любой строки ничего не записывается
+type ImportPermissionsUseCase struct {
+	Repo  repositories.PermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewImportPermissionsUseCase(repo repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *ImportPermissionsUseCase {
+	return &ImportPermissionsUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ImportPermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ImportDTO) error {
+	return transfer.Validate(input)
+}
+
+func (u *ImportPermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ImportDTO) (*dto.ImportReportRDTO, error) {
+	var report *dto.ImportReportRDTO
+	err := u.Tx.Do(ctx, func(ctx context.Context) error {
+		locked, err := u.Repo.LockByValues(ctx, transfer.Values(input.Rows))
+		if err != nil {
+			return err
+		}
+		current := make(map[string]generated.Permission, len(locked))
+		existing := make(map[string]transfer.Existing, len(locked))
+		for _, permission := range locked {
+			current[permission.Value] = permission
+			existing[permission.Value] = transfer.Existing{
+				Record:  mapper.TransferRecordFromPermissionSQLC(permission),
+				Deleted: permission.DeletedAt.Valid,
+			}
+		}
+
+		statuses, err := transfer.Plan(input.Rows, existing, transfer.StrategyOf(input))
+		if err != nil {
+			return err
+		}
+		report = transfer.NewReport(input, statuses)
+		if input.ValidateOnly {
+			return nil
+		}
+
+		var creates []generated.BulkCreatePermissionsParams
+		var updates []generated.BulkUpdatePermissionsParams
+		var createdValues []string
+		for i, row := range input.Rows {
+			switch statuses[i] {
+			case transfer.StatusCreated:
+				creates = append(creates, mapper.BulkCreatePermissionsParamsFromTransferRecord(row))
+				createdValues = append(createdValues, row.Value)
+			case transfer.StatusUpdated:
+				updates = append(updates, mapper.BulkUpdatePermissionsParamsFromTransferRecord(current[row.Value].ID, row))
+			}
+		}
+
+		if _, err := u.Repo.BulkCreate(ctx, creates); err != nil {
+			return err
+		}
+		updated, err := u.Repo.BulkUpdate(ctx, updates)
+		if err != nil {
+			return err
+		}
+
+		// COPY не возвращает строки: созданные разрешения перечитываются для журнала
+		created, err := u.Repo.LockByValues(ctx, createdValues)
+		if err != nil {
+			return err
+		}
+		for _, permission := range created {
+			if err := u.Audit.Record(fiberCtx, ctx, audit.Entry{
+				Action:   audit.ActionCreate,
+				Entity:   audit.EntityPermission,
+				EntityID: permission.ID,
+				After:    permission,
+			}); err != nil {
+				return err
+			}
+		}
+		for _, permission := range updated {
+			if err := u.Audit.Record(fiberCtx, ctx, audit.Entry{
+				Action:   audit.ActionUpdate,
+				Entity:   audit.EntityPermission,
+				EntityID: permission.ID,
+				Before:   current[permission.Value],
+				After:    permission,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (u *ImportPermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.ImportReportRDTO) (any, error) {
+	return result, nil
+}
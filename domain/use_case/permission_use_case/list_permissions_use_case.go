@@ -0,0 +1,55 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PermissionPaginationOptions - допустимые параметры списка разрешений
+// Поля сортировки совпадают с ветками ORDER BY в sqlc запросах ListAllPermissions/PaginateAllPermissions
+var PermissionPaginationOptions = pagination.Options{
+	SortFields:    []string{"created_at", "updated_at", "title_ru", "value"},
+	DefaultSortBy: "created_at",
+	Searchable:    true,
+	SoftDeletable: true,
+	Filters:       []string{"values", "ids"},
+	UUIDFilters:   []string{"ids"},
+}
+
+type ListPermissionsUseCase struct {
+	Repo repositories.PermissionRepository
+}
+
+func NewListPermissionsUseCase(repo repositories.PermissionRepository) *ListPermissionsUseCase {
+	return &ListPermissionsUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ListPermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	return input.Validate(PermissionPaginationOptions)
+}
+
+func (u *ListPermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) ([]dto.PermissionRDTO, error) {
+	permissionsSQLC, err := u.Repo.List(ctx, generated.ListAllPermissionsParams{
+		ShowDeleted: input.ShowDeletedBool(),
+		Search:      input.SearchText(),
+		Values:      input.Strings("values"),
+		Ids:         input.UUIDs("ids"),
+		SortBy:      input.SortBy,
+		SortOrder:   input.SortOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.PermissionRDTOListFromListSQLC(fiberCtx, permissionsSQLC), nil
+}
+
+func (u *ListPermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
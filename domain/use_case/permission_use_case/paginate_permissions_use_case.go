@@ -0,0 +1,61 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type PaginatePermissionsUseCase struct {
+	Repo repositories.PermissionRepository
+}
+
+func NewPaginatePermissionsUseCase(repo repositories.PermissionRepository) *PaginatePermissionsUseCase {
+	return &PaginatePermissionsUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *PaginatePermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	return input.Validate(PermissionPaginationOptions)
+}
+
+func (u *PaginatePermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.Page[dto.PermissionRDTO], error) {
+	permissionsSQLC, total, err := pagination.Fetch(ctx,
+		func(ctx context.Context) ([]generated.PaginateAllPermissionsRow, error) {
+			return u.Repo.Paginate(ctx, generated.PaginateAllPermissionsParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				OnlyDeleted: input.OnlyDeletedBool(),
+				Search:      input.SearchText(),
+				Values:      input.Strings("values"),
+				Ids:         input.UUIDs("ids"),
+				SortBy:      input.SortBy,
+				SortOrder:   input.SortOrder,
+				Offset:      input.Offset(),
+				Limit:       input.Limit(),
+			})
+		},
+		func(ctx context.Context) (int64, error) {
+			return u.Repo.Count(ctx, generated.CountAllPermissionsParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				OnlyDeleted: input.OnlyDeletedBool(),
+				Search:      input.SearchText(),
+				Values:      input.Strings("values"),
+				Ids:         input.UUIDs("ids"),
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(mapper.PermissionRDTOListFromPaginateSQLC(fiberCtx, permissionsSQLC), input, total), nil
+}
+
+func (u *PaginatePermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.Page[dto.PermissionRDTO]) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SeekPermissionsUseCase - keyset (курсорная) пагинация разрешений по (created_at, id) DESC
+type SeekPermissionsUseCase struct {
+	Repo  repositories.PermissionRepository
+	Codec *pagination.CursorCodec
+}
+
+func NewSeekPermissionsUseCase(repo repositories.PermissionRepository, codec *pagination.CursorCodec) *SeekPermissionsUseCase {
+	return &SeekPermissionsUseCase{Repo: repo, Codec: codec}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *SeekPermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	if err := input.Validate(PermissionPaginationOptions); err != nil {
+		return err
+	}
+	if input.Cursor == "" {
+		return nil
+	}
+	_, err := u.Codec.Decode(input.Cursor)
+	return err
+}
+
+func (u *SeekPermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.CursorPage[dto.PermissionRDTO], error) {
+	var cursor *pagination.Cursor
+	if input.Cursor != "" {
+		decoded, err := u.Codec.Decode(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	return pagination.Seek(ctx, u.Codec, input, cursor,
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.PermissionRDTO, error) {
+			permissionsSQLC, err := u.Repo.SeekAfter(ctx, generated.SeekPermissionsAfterParams{
+				ShowDeleted:     input.ShowDeletedBool(),
+				Search:          input.SearchText(),
+				Values:          input.Strings("values"),
+				Ids:             input.UUIDs("ids"),
+				CursorCreatedAt: cursor.Timestamp(),
+				CursorID:        cursor.UUID(),
+				Limit:           limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.PermissionRDTOListFromSeekAfterSQLC(fiberCtx, permissionsSQLC), nil
+		},
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.PermissionRDTO, error) {
+			permissionsSQLC, err := u.Repo.SeekBefore(ctx, generated.SeekPermissionsBeforeParams{
+				ShowDeleted:     input.ShowDeletedBool(),
+				Search:          input.SearchText(),
+				Values:          input.Strings("values"),
+				Ids:             input.UUIDs("ids"),
+				CursorCreatedAt: cursor.Timestamp(),
+				CursorID:        cursor.UUID(),
+				Limit:           limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.PermissionRDTOListFromSeekBeforeSQLC(fiberCtx, permissionsSQLC), nil
+		},
+		func(permission dto.PermissionRDTO) (time.Time, string) {
+			return permission.CreatedAt, permission.ID
+		},
+	)
+}
+
+func (u *SeekPermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.CursorPage[dto.PermissionRDTO]) (any, error) {
+	return result, nil
+}
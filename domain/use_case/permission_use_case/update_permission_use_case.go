@@ -0,0 +1,103 @@
+package permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"clean_architecture_fiber/pkg/etag"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpdatePermissionInput - IfMatch должен содержать ETag из GET (или "*"), иначе обновление отклоняется
+type UpdatePermissionInput struct {
+	ID      string            `params:"id" validate:"required,uuid"`
+	IfMatch string            `header:"If-Match"`
+	Data    dto.PermissionDTO `body:"true"`
+}
+
+type UpdatePermissionUseCase struct {
+	Repo  repositories.PermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewUpdatePermissionUseCase(repo repositories.PermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *UpdatePermissionUseCase {
+	return &UpdatePermissionUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *UpdatePermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input UpdatePermissionInput) error {
+	if input.IfMatch == "" {
+		return domain_error.PreconditionRequired("")
+	}
+	return validation.Struct(input)
+}
+
+// Execute сверяет If-Match с текущей версией и обновляет строку условным запросом
+// Если версия не совпала или строку изменили между чтением и записью - 412 с актуальным представлением
+// Изменение и запись в журнал выполняются в одной транзакции
+func (u *UpdatePermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input UpdatePermissionInput) (*dto.PermissionRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	currentRDTO := mapper.PermissionRDTOFromPermissionByIdSQLC(fiberCtx, *current)
+	if !etag.Matches(input.IfMatch, currentRDTO.ETag()) {
+		return nil, domain_error.PreconditionFailed("").WithCurrent(&currentRDTO)
+	}
+
+	var permissionSQLC *generated.Permission
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		permissionSQLC, err = u.Repo.UpdateIfUnmodified(ctx, mapper.UpdatePermissionByIdIfUnmodifiedParamsFromPermissionDTO(id, input.Data, current.UpdatedAt))
+		if domain_error.Is(err, domain_error.KindNotFound) {
+			return u.preconditionFailed(fiberCtx, ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionUpdate,
+			Entity:   audit.EntityPermission,
+			EntityID: id,
+			Before:   before,
+			After:    permissionSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := mapper.PermissionRDTOFromPermissionSQLC(fiberCtx, *permissionSQLC)
+	return &result, nil
+}
+
+func (u *UpdatePermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
+
+// preconditionFailed перечитывает разрешение после неудачного условного обновления
+// Удаленная запись дает 404, измененная - 412 с актуальным представлением
+func (u *UpdatePermissionUseCase) preconditionFailed(fiberCtx *fiber.Ctx, ctx context.Context, id pgtype.UUID) error {
+	current, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	result := mapper.PermissionRDTOFromPermissionByIdSQLC(fiberCtx, *current)
+	return domain_error.PreconditionFailed("").WithCurrent(&result)
+}
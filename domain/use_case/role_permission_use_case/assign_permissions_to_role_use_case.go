@@ -0,0 +1,101 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ChangeRolePermissionsInput используется для назначения, снятия и замены разрешений роли
+type ChangeRolePermissionsInput struct {
+	RoleID string                 `params:"id" validate:"required,uuid"`
+	Data   dto.RolePermissionsDTO `body:"true"`
+}
+
+// validate проверяет ID роли и ID разрешений
+// allowEmpty разрешает пустой список (для замены - означает снятие всех разрешений)
+func (input ChangeRolePermissionsInput) validate(allowEmpty bool) error {
+	if err := validation.Struct(input); err != nil {
+		return err
+	}
+	if !allowEmpty && len(input.Data.PermissionIDs) == 0 {
+		return validation.Fields(validation.Field("permission_ids", "required", ""))
+	}
+	return nil
+}
+
+// parse преобразует ID роли и ID разрешений в pgtype.UUID
+// Список разрешений никогда не возвращается как nil, чтобы unnest получил пустой массив, а не NULL
+func (input ChangeRolePermissionsInput) parse() (pgtype.UUID, []pgtype.UUID, error) {
+	roleID, err := mapper.ParseUUID(input.RoleID)
+	if err != nil {
+		return pgtype.UUID{}, nil, err
+	}
+	permissionIDs, err := mapper.ParseUUIDs(input.Data.PermissionIDs)
+	if err != nil {
+		return pgtype.UUID{}, nil, err
+	}
+	if permissionIDs == nil {
+		permissionIDs = []pgtype.UUID{}
+	}
+	return roleID, permissionIDs, nil
+}
+
+// AssignPermissionsToRoleUseCase добавляет разрешения к роли (уже назначенные пропускаются)
+type AssignPermissionsToRoleUseCase struct {
+	Repo  repositories.RolePermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewAssignPermissionsToRoleUseCase(repo repositories.RolePermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *AssignPermissionsToRoleUseCase {
+	return &AssignPermissionsToRoleUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *AssignPermissionsToRoleUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) error {
+	return input.validate(false)
+}
+
+func (u *AssignPermissionsToRoleUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) ([]dto.PermissionRDTO, error) {
+	roleID, permissionIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var permissionsSQLC []generated.Permission
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetRolePermissions(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if _, err := u.Repo.AssignPermissions(ctx, roleID, permissionIDs); err != nil {
+			return err
+		}
+		if permissionsSQLC, err = u.Repo.GetRolePermissions(ctx, roleID); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionAssign,
+			Entity:   audit.EntityRolePermission,
+			EntityID: roleID,
+			Before:   audit.PermissionValues(before),
+			After:    audit.PermissionValues(permissionsSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.PermissionRDTOListFromPermissionsSQLC(fiberCtx, permissionsSQLC), nil
+}
+
+func (u *AssignPermissionsToRoleUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CheckRoleHasPermissionInput struct {
+	RoleValue       string `query:"role" validate:"required"`
+	PermissionValue string `query:"permission" validate:"required"`
+}
+
+// CheckRoleHasPermissionUseCase проверяет, назначено ли роли разрешение (по значениям value)
+type CheckRoleHasPermissionUseCase struct {
+	Repo repositories.RolePermissionRepository
+}
+
+func NewCheckRoleHasPermissionUseCase(repo repositories.RolePermissionRepository) *CheckRoleHasPermissionUseCase {
+	return &CheckRoleHasPermissionUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *CheckRoleHasPermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input CheckRoleHasPermissionInput) error {
+	return validation.Struct(input)
+}
+
+func (u *CheckRoleHasPermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input CheckRoleHasPermissionInput) (*dto.RolePermissionCheckRDTO, error) {
+	hasPermission, err := u.Repo.HasPermission(ctx, input.RoleValue, input.PermissionValue)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RolePermissionCheckRDTO{
+		Role:          input.RoleValue,
+		Permission:    input.PermissionValue,
+		HasPermission: hasPermission,
+	}, nil
+}
+
+func (u *CheckRoleHasPermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RolePermissionCheckRDTO) (any, error) {
+	return result, nil
+}
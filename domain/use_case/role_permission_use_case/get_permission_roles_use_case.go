@@ -0,0 +1,45 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetPermissionRolesInput struct {
+	PermissionID string `params:"id" validate:"required,uuid"`
+}
+
+// GetPermissionRolesUseCase возвращает активные роли, которым назначено разрешение
+type GetPermissionRolesUseCase struct {
+	Repo repositories.RolePermissionRepository
+}
+
+func NewGetPermissionRolesUseCase(repo repositories.RolePermissionRepository) *GetPermissionRolesUseCase {
+	return &GetPermissionRolesUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetPermissionRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionRolesInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetPermissionRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetPermissionRolesInput) ([]dto.RoleRDTO, error) {
+	permissionID, err := mapper.ParseUUID(input.PermissionID)
+	if err != nil {
+		return nil, err
+	}
+	rolesSQLC, err := u.Repo.GetPermissionRoles(ctx, permissionID)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromRolesSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *GetPermissionRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetRolePermissionsInput struct {
+	RoleID string `params:"id" validate:"required,uuid"`
+}
+
+// GetRolePermissionsUseCase возвращает активные разрешения роли
+type GetRolePermissionsUseCase struct {
+	Repo repositories.RolePermissionRepository
+}
+
+func NewGetRolePermissionsUseCase(repo repositories.RolePermissionRepository) *GetRolePermissionsUseCase {
+	return &GetRolePermissionsUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetRolePermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetRolePermissionsInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetRolePermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetRolePermissionsInput) ([]dto.PermissionRDTO, error) {
+	roleID, err := mapper.ParseUUID(input.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	permissionsSQLC, err := u.Repo.GetRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.PermissionRDTOListFromPermissionsSQLC(fiberCtx, permissionsSQLC), nil
+}
+
+func (u *GetRolePermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
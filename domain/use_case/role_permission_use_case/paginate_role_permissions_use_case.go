@@ -0,0 +1,73 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RolePermissionPaginationOptions - допустимые параметры списка связей роль-разрешение
+// Связи не поддерживают поиск и мягкое удаление, фильтруются по ID и value роли/разрешения
+var RolePermissionPaginationOptions = pagination.Options{
+	SortFields:    []string{"created_at", "role_value", "permission_value"},
+	DefaultSortBy: "created_at",
+	Filters:       []string{"role_ids", "permission_ids", "role_values", "permission_values"},
+	UUIDFilters:   []string{"role_ids", "permission_ids"},
+}
+
+type PaginateRolePermissionsUseCase struct {
+	Repo repositories.RolePermissionRepository
+}
+
+func NewPaginateRolePermissionsUseCase(repo repositories.RolePermissionRepository) *PaginateRolePermissionsUseCase {
+	return &PaginateRolePermissionsUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *PaginateRolePermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	return input.Validate(RolePermissionPaginationOptions)
+}
+
+func (u *PaginateRolePermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.Page[dto.RolePermissionRDTO], error) {
+	rowsSQLC, total, err := pagination.Fetch(ctx,
+		func(ctx context.Context) ([]generated.PaginateAllRolePermissionsRow, error) {
+			return u.Repo.Paginate(ctx, generated.PaginateAllRolePermissionsParams{
+				RoleIds:          input.UUIDs("role_ids"),
+				PermissionIds:    input.UUIDs("permission_ids"),
+				RoleValues:       input.Strings("role_values"),
+				PermissionValues: input.Strings("permission_values"),
+				SortBy:           input.SortBy,
+				SortOrder:        input.SortOrder,
+				Offset:           input.Offset(),
+				Limit:            input.Limit(),
+			})
+		},
+		func(ctx context.Context) (int64, error) {
+			return u.Repo.Count(ctx, generated.CountAllRolePermissionsParams{
+				RoleIds:          input.UUIDs("role_ids"),
+				PermissionIds:    input.UUIDs("permission_ids"),
+				RoleValues:       input.Strings("role_values"),
+				PermissionValues: input.Strings("permission_values"),
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := mapper.RolePermissionRDTOListFromPaginateSQLC(fiberCtx, rowsSQLC)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(items, input, total), nil
+}
+
+func (u *PaginateRolePermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.Page[dto.RolePermissionRDTO]) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RemovePermissionsFromRoleUseCase снимает указанные разрешения с роли
+type RemovePermissionsFromRoleUseCase struct {
+	Repo  repositories.RolePermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewRemovePermissionsFromRoleUseCase(repo repositories.RolePermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *RemovePermissionsFromRoleUseCase {
+	return &RemovePermissionsFromRoleUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *RemovePermissionsFromRoleUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) error {
+	return input.validate(false)
+}
+
+func (u *RemovePermissionsFromRoleUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) ([]dto.PermissionRDTO, error) {
+	roleID, permissionIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var permissionsSQLC []generated.Permission
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetRolePermissions(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if _, err := u.Repo.RemovePermissions(ctx, roleID, permissionIDs); err != nil {
+			return err
+		}
+		if permissionsSQLC, err = u.Repo.GetRolePermissions(ctx, roleID); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionRemove,
+			Entity:   audit.EntityRolePermission,
+			EntityID: roleID,
+			Before:   audit.PermissionValues(before),
+			After:    audit.PermissionValues(permissionsSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.PermissionRDTOListFromPermissionsSQLC(fiberCtx, permissionsSQLC), nil
+}
+
+func (u *RemovePermissionsFromRoleUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
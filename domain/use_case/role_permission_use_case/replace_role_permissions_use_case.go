@@ -0,0 +1,62 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReplaceRolePermissionsUseCase заменяет весь набор разрешений роли
+// Замена выполняется в одной транзакции, поэтому роль никогда не остается с частично обновленным набором
+type ReplaceRolePermissionsUseCase struct {
+	Repo  repositories.RolePermissionRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewReplaceRolePermissionsUseCase(repo repositories.RolePermissionRepository, tx *db.TxManager, recorder *audit.Recorder) *ReplaceRolePermissionsUseCase {
+	return &ReplaceRolePermissionsUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ReplaceRolePermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) error {
+	return input.validate(true)
+}
+
+func (u *ReplaceRolePermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeRolePermissionsInput) ([]dto.PermissionRDTO, error) {
+	roleID, permissionIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var permissionsSQLC []generated.Permission
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetRolePermissions(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if permissionsSQLC, err = u.Repo.ReplacePermissions(ctx, roleID, permissionIDs); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionReplace,
+			Entity:   audit.EntityRolePermission,
+			EntityID: roleID,
+			Before:   audit.PermissionValues(before),
+			After:    audit.PermissionValues(permissionsSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.PermissionRDTOListFromPermissionsSQLC(fiberCtx, permissionsSQLC), nil
+}
+
+func (u *ReplaceRolePermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.PermissionRDTO) (any, error) {
+	return result, nil
+}
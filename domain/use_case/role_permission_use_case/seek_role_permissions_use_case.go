@@ -0,0 +1,87 @@
+package role_permission_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SeekRolePermissionsUseCase - keyset (курсорная) пагинация связей роль-разрешение по (created_at, id) DESC
+type SeekRolePermissionsUseCase struct {
+	Repo  repositories.RolePermissionRepository
+	Codec *pagination.CursorCodec
+}
+
+func NewSeekRolePermissionsUseCase(repo repositories.RolePermissionRepository, codec *pagination.CursorCodec) *SeekRolePermissionsUseCase {
+	return &SeekRolePermissionsUseCase{Repo: repo, Codec: codec}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *SeekRolePermissionsUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	if err := input.Validate(RolePermissionPaginationOptions); err != nil {
+		return err
+	}
+	if input.Cursor == "" {
+		return nil
+	}
+	_, err := u.Codec.Decode(input.Cursor)
+	return err
+}
+
+func (u *SeekRolePermissionsUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.CursorPage[dto.RolePermissionRDTO], error) {
+	var cursor *pagination.Cursor
+	if input.Cursor != "" {
+		decoded, err := u.Codec.Decode(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	return pagination.Seek(ctx, u.Codec, input, cursor,
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.RolePermissionRDTO, error) {
+			rowsSQLC, err := u.Repo.SeekAfter(ctx, generated.SeekRolePermissionsAfterParams{
+				RoleIds:          input.UUIDs("role_ids"),
+				PermissionIds:    input.UUIDs("permission_ids"),
+				RoleValues:       input.Strings("role_values"),
+				PermissionValues: input.Strings("permission_values"),
+				CursorCreatedAt:  cursor.Timestamp(),
+				CursorID:         cursor.UUID(),
+				Limit:            limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RolePermissionRDTOListFromSeekAfterSQLC(fiberCtx, rowsSQLC)
+		},
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.RolePermissionRDTO, error) {
+			rowsSQLC, err := u.Repo.SeekBefore(ctx, generated.SeekRolePermissionsBeforeParams{
+				RoleIds:          input.UUIDs("role_ids"),
+				PermissionIds:    input.UUIDs("permission_ids"),
+				RoleValues:       input.Strings("role_values"),
+				PermissionValues: input.Strings("permission_values"),
+				CursorCreatedAt:  cursor.Timestamp(),
+				CursorID:         cursor.UUID(),
+				Limit:            limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RolePermissionRDTOListFromSeekBeforeSQLC(fiberCtx, rowsSQLC)
+		},
+		func(rolePermission dto.RolePermissionRDTO) (time.Time, string) {
+			return rolePermission.CreatedAt, rolePermission.ID
+		},
+	)
+}
+
+func (u *SeekRolePermissionsUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.CursorPage[dto.RolePermissionRDTO]) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateRoleUseCase struct {
+	Repo  repositories.RoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewCreateRoleUseCase(repo repositories.RoleRepository, tx *db.TxManager, recorder *audit.Recorder) *CreateRoleUseCase {
+	return &CreateRoleUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *CreateRoleUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.RoleDTO) error {
+	return validation.Struct(input)
+}
+
+func (u *CreateRoleUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.RoleDTO) (*dto.RoleRDTO, error) {
+	var roleSQLC *generated.Role
+	err := u.Tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if roleSQLC, err = u.Repo.Create(ctx, mapper.CreateOneRoleParamsFromRoleDTO(input)); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionCreate,
+			Entity:   audit.EntityRole,
+			EntityID: roleSQLC.ID,
+			After:    roleSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOFromRoleModelSQLC(fiberCtx, *roleSQLC), nil
+}
+
+func (u *CreateRoleUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/transfer"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportRolesUseCase выгружает все неудаленные роли в формате, который принимает ImportRolesUseCase
+type ExportRolesUseCase struct {
+	Repo repositories.RoleRepository
+}
+
+func NewExportRolesUseCase(repo repositories.RoleRepository) *ExportRolesUseCase {
+	return &ExportRolesUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ExportRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ExportDTO) error {
+	_, err := transfer.FormatOf(input.Format, "")
+	return err
+}
+
+func (u *ExportRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ExportDTO) (*dto.FileRDTO, error) {
+	rolesSQLC, err := u.Repo.ListForExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	format, _ := transfer.FormatOf(input.Format, "")
+	content, err := transfer.Encode(format, mapper.TransferRecordListFromRolesSQLC(rolesSQLC))
+	if err != nil {
+		return nil, err
+	}
+	return &dto.FileRDTO{
+		Name:        "roles." + format,
+		ContentType: transfer.ContentType(format),
+		Content:     content,
+	}, nil
+}
+
+func (u *ExportRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.FileRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetRoleByIdInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+type GetRoleByIdUseCase struct {
+	Repo repositories.RoleRepository
+}
+
+func NewGetRoleByIdUseCase(repo repositories.RoleRepository) *GetRoleByIdUseCase {
+	return &GetRoleByIdUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetRoleByIdUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetRoleByIdInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetRoleByIdUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetRoleByIdInput) (*dto.RoleRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	roleSQLC, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if roleSQLC == nil {
+		return nil, domain_error.NotFound("role.not_found")
+	}
+	return mapper.RoleRDTOFromRoleByIdSQLC(fiberCtx, roleSQLC), nil
+}
+
+func (u *GetRoleByIdUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -1,17 +1,17 @@
 package role_use_case
 
 import (
+	"clean_architecture_fiber/domain/domain_error"
 	"clean_architecture_fiber/domain/dto"
 	"clean_architecture_fiber/domain/mapper"
 	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
 	"context"
-	"errors"
-	"fmt"
 	"github.com/gofiber/fiber/v2"
 )
 
 type GetRoleByValueInput struct {
-	Value string
+	Value string `params:"value" validate:"required"`
 }
 
 type GetRoleByValueUseCase struct {
@@ -25,10 +25,7 @@ func NewGetRoleByValueUseCase(repo repositories.RoleRepository) *GetRoleByValueU
 // --- Реализация UseCase интерфейса ---
 
 func (u *GetRoleByValueUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetRoleByValueInput) error {
-	if input.Value == "" {
-		return errors.New("value cannot be empty")
-	}
-	return nil
+	return validation.Struct(input)
 }
 
 func (u *GetRoleByValueUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetRoleByValueInput) (*dto.RoleRDTO, error) {
@@ -37,11 +34,11 @@ func (u *GetRoleByValueUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context
 		return nil, err
 	}
 	if roleSQLC == nil {
-		return nil, fmt.Errorf("role not found")
+		return nil, domain_error.NotFound("role.not_found")
 	}
 	return mapper.RoleRDTOFromRoleSQLC(fiberCtx, roleSQLC), nil
 }
 
-func (u *GetRoleByValueUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result dto.RoleRDTO) (any, error) {
+func (u *GetRoleByValueUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RoleRDTO) (any, error) {
 	return result, nil
 }
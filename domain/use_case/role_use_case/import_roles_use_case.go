@@ -0,0 +1,116 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/transfer"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ImportRolesUseCase создает и обновляет роли из файла, сопоставляя их по value
+// Импорт выполняется целиком в одной транзакции: при ошибке любой строки ничего не записывается
+type ImportRolesUseCase struct {
+	Repo  repositories.RoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewImportRolesUseCase(repo repositories.RoleRepository, tx *db.TxManager, recorder *audit.Recorder) *ImportRolesUseCase {
+	return &ImportRolesUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ImportRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ImportDTO) error {
+	return transfer.Validate(input)
+}
+
+func (u *ImportRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.ImportDTO) (*dto.ImportReportRDTO, error) {
+	var report *dto.ImportReportRDTO
+	err := u.Tx.Do(ctx, func(ctx context.Context) error {
+		locked, err := u.Repo.LockByValues(ctx, transfer.Values(input.Rows))
+		if err != nil {
+			return err
+		}
+		current := make(map[string]generated.Role, len(locked))
+		existing := make(map[string]transfer.Existing, len(locked))
+		for _, role := range locked {
+			current[role.Value] = role
+			existing[role.Value] = transfer.Existing{
+				Record:  mapper.TransferRecordFromRoleSQLC(role),
+				Deleted: role.DeletedAt.Valid,
+			}
+		}
+
+		statuses, err := transfer.Plan(input.Rows, existing, transfer.StrategyOf(input))
+		if err != nil {
+			return err
+		}
+		report = transfer.NewReport(input, statuses)
+		if input.ValidateOnly {
+			return nil
+		}
+
+		var creates []generated.BulkCreateRolesParams
+		var updates []generated.BulkUpdateRolesParams
+		var createdValues []string
+		for i, row := range input.Rows {
+			switch statuses[i] {
+			case transfer.StatusCreated:
+				creates = append(creates, mapper.BulkCreateRolesParamsFromTransferRecord(row))
+				createdValues = append(createdValues, row.Value)
+			case transfer.StatusUpdated:
+				updates = append(updates, mapper.BulkUpdateRolesParamsFromTransferRecord(current[row.Value].ID, row))
+			}
+		}
+
+		if _, err := u.Repo.BulkCreate(ctx, creates); err != nil {
+			return err
+		}
+		updated, err := u.Repo.BulkUpdate(ctx, updates)
+		if err != nil {
+			return err
+		}
+
+		// COPY не возвращает строки: созданные роли перечитываются для журнала
+		created, err := u.Repo.LockByValues(ctx, createdValues)
+		if err != nil {
+			return err
+		}
+		for _, role := range created {
+			if err := u.Audit.Record(fiberCtx, ctx, audit.Entry{
+				Action:   audit.ActionCreate,
+				Entity:   audit.EntityRole,
+				EntityID: role.ID,
+				After:    role,
+			}); err != nil {
+				return err
+			}
+		}
+		for _, role := range updated {
+			if err := u.Audit.Record(fiberCtx, ctx, audit.Entry{
+				Action:   audit.ActionUpdate,
+				Entity:   audit.EntityRole,
+				EntityID: role.ID,
+				Before:   current[role.Value],
+				After:    role,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (u *ImportRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.ImportReportRDTO) (any, error) {
+	return result, nil
+}
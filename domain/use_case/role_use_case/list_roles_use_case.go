@@ -0,0 +1,55 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RolePaginationOptions - допустимые параметры списка ролей
+// Поля сортировки совпадают с ветками ORDER BY в sqlc запросах ListAllRoles/PaginateAllRoles
+var RolePaginationOptions = pagination.Options{
+	SortFields:    []string{"created_at", "updated_at", "title_ru", "value"},
+	DefaultSortBy: "created_at",
+	Searchable:    true,
+	SoftDeletable: true,
+	Filters:       []string{"values", "ids"},
+	UUIDFilters:   []string{"ids"},
+}
+
+type ListRolesUseCase struct {
+	Repo repositories.RoleRepository
+}
+
+func NewListRolesUseCase(repo repositories.RoleRepository) *ListRolesUseCase {
+	return &ListRolesUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ListRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	return input.Validate(RolePaginationOptions)
+}
+
+func (u *ListRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) ([]dto.RoleRDTO, error) {
+	rolesSQLC, err := u.Repo.List(ctx, generated.ListAllRolesParams{
+		ShowDeleted: input.ShowDeletedBool(),
+		Search:      input.SearchText(),
+		Values:      input.Strings("values"),
+		Ids:         input.UUIDs("ids"),
+		SortBy:      input.SortBy,
+		SortOrder:   input.SortOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromListSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *ListRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
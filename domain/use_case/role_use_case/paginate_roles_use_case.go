@@ -0,0 +1,61 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type PaginateRolesUseCase struct {
+	Repo repositories.RoleRepository
+}
+
+func NewPaginateRolesUseCase(repo repositories.RoleRepository) *PaginateRolesUseCase {
+	return &PaginateRolesUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *PaginateRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	return input.Validate(RolePaginationOptions)
+}
+
+func (u *PaginateRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.Page[dto.RoleRDTO], error) {
+	rolesSQLC, total, err := pagination.Fetch(ctx,
+		func(ctx context.Context) ([]generated.PaginateAllRolesRow, error) {
+			return u.Repo.Paginate(ctx, generated.PaginateAllRolesParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				OnlyDeleted: input.OnlyDeletedBool(),
+				Search:      input.SearchText(),
+				Values:      input.Strings("values"),
+				Ids:         input.UUIDs("ids"),
+				SortBy:      input.SortBy,
+				SortOrder:   input.SortOrder,
+				Offset:      input.Offset(),
+				Limit:       input.Limit(),
+			})
+		},
+		func(ctx context.Context) (int64, error) {
+			return u.Repo.Count(ctx, generated.CountAllRolesParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				OnlyDeleted: input.OnlyDeletedBool(),
+				Search:      input.SearchText(),
+				Values:      input.Strings("values"),
+				Ids:         input.UUIDs("ids"),
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(mapper.RoleRDTOListFromPaginateSQLC(fiberCtx, rolesSQLC), input, total), nil
+}
+
+func (u *PaginateRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.Page[dto.RoleRDTO]) (any, error) {
+	return result, nil
+}
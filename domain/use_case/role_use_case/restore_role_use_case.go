@@ -0,0 +1,66 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RestoreRoleInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+// RestoreRoleUseCase восстанавливает мягко удаленную роль (очищает deleted_at)
+type RestoreRoleUseCase struct {
+	Repo  repositories.RoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewRestoreRoleUseCase(repo repositories.RoleRepository, tx *db.TxManager, recorder *audit.Recorder) *RestoreRoleUseCase {
+	return &RestoreRoleUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *RestoreRoleUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input RestoreRoleInput) error {
+	return validation.Struct(input)
+}
+
+func (u *RestoreRoleUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input RestoreRoleInput) (*dto.RoleRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	var roleSQLC *generated.Role
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if roleSQLC, err = u.Repo.Restore(ctx, id); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionRestore,
+			Entity:   audit.EntityRole,
+			EntityID: id,
+			Before:   before,
+			After:    roleSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOFromRoleModelSQLC(fiberCtx, *roleSQLC), nil
+}
+
+func (u *RestoreRoleUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RoleRDTO) (any, error) {
+	return result, nil
+}
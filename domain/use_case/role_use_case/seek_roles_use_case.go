@@ -0,0 +1,87 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SeekRolesUseCase - keyset (курсорная) пагинация ролей по (created_at, id) DESC
+type SeekRolesUseCase struct {
+	Repo  repositories.RoleRepository
+	Codec *pagination.CursorCodec
+}
+
+func NewSeekRolesUseCase(repo repositories.RoleRepository, codec *pagination.CursorCodec) *SeekRolesUseCase {
+	return &SeekRolesUseCase{Repo: repo, Codec: codec}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *SeekRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	if err := input.Validate(RolePaginationOptions); err != nil {
+		return err
+	}
+	if input.Cursor == "" {
+		return nil
+	}
+	_, err := u.Codec.Decode(input.Cursor)
+	return err
+}
+
+func (u *SeekRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.CursorPage[dto.RoleRDTO], error) {
+	var cursor *pagination.Cursor
+	if input.Cursor != "" {
+		decoded, err := u.Codec.Decode(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	return pagination.Seek(ctx, u.Codec, input, cursor,
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.RoleRDTO, error) {
+			rolesSQLC, err := u.Repo.SeekAfter(ctx, generated.SeekRolesAfterParams{
+				ShowDeleted:     input.ShowDeletedBool(),
+				Search:          input.SearchText(),
+				Values:          input.Strings("values"),
+				Ids:             input.UUIDs("ids"),
+				CursorCreatedAt: cursor.Timestamp(),
+				CursorID:        cursor.UUID(),
+				Limit:           limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RoleRDTOListFromSeekAfterSQLC(fiberCtx, rolesSQLC), nil
+		},
+		func(ctx context.Context, cursor *pagination.Cursor, limit int32) ([]dto.RoleRDTO, error) {
+			rolesSQLC, err := u.Repo.SeekBefore(ctx, generated.SeekRolesBeforeParams{
+				ShowDeleted:     input.ShowDeletedBool(),
+				Search:          input.SearchText(),
+				Values:          input.Strings("values"),
+				Ids:             input.UUIDs("ids"),
+				CursorCreatedAt: cursor.Timestamp(),
+				CursorID:        cursor.UUID(),
+				Limit:           limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RoleRDTOListFromSeekBeforeSQLC(fiberCtx, rolesSQLC), nil
+		},
+		func(role dto.RoleRDTO) (time.Time, string) {
+			return role.CreatedAt, role.ID
+		},
+	)
+}
+
+func (u *SeekRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.CursorPage[dto.RoleRDTO]) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"clean_architecture_fiber/pkg/etag"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpdateRoleInput - IfMatch должен содержать ETag из GET (или "*"), иначе обновление отклоняется
+type UpdateRoleInput struct {
+	ID      string      `params:"id" validate:"required,uuid"`
+	IfMatch string      `header:"If-Match"`
+	Data    dto.RoleDTO `body:"true"`
+}
+
+type UpdateRoleUseCase struct {
+	Repo  repositories.RoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewUpdateRoleUseCase(repo repositories.RoleRepository, tx *db.TxManager, recorder *audit.Recorder) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *UpdateRoleUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input UpdateRoleInput) error {
+	if input.IfMatch == "" {
+		return domain_error.PreconditionRequired("")
+	}
+	return validation.Struct(input)
+}
+
+// Execute сверяет If-Match с текущей версией и обновляет строку условным запросом
+// Если версия не совпала или строку изменили между чтением и записью - 412 с актуальным представлением
+// Изменение и запись в журнал выполняются в одной транзакции
+func (u *UpdateRoleUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input UpdateRoleInput) (*dto.RoleRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	currentRDTO := mapper.RoleRDTOFromRoleByIdSQLC(fiberCtx, current)
+	if !etag.Matches(input.IfMatch, currentRDTO.ETag()) {
+		return nil, domain_error.PreconditionFailed("").WithCurrent(currentRDTO)
+	}
+
+	var roleSQLC *generated.Role
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		roleSQLC, err = u.Repo.UpdateIfUnmodified(ctx, mapper.UpdateRoleByIdIfUnmodifiedParamsFromRoleDTO(id, input.Data, current.UpdatedAt))
+		if domain_error.Is(err, domain_error.KindNotFound) {
+			return u.preconditionFailed(fiberCtx, ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionUpdate,
+			Entity:   audit.EntityRole,
+			EntityID: id,
+			Before:   before,
+			After:    roleSQLC,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOFromRoleModelSQLC(fiberCtx, *roleSQLC), nil
+}
+
+func (u *UpdateRoleUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.RoleRDTO) (any, error) {
+	return result, nil
+}
+
+// preconditionFailed перечитывает роль после неудачного условного обновления
+// Удаленная роль дает 404, измененная - 412 с актуальным представлением
+func (u *UpdateRoleUseCase) preconditionFailed(fiberCtx *fiber.Ctx, ctx context.Context, id pgtype.UUID) error {
+	current, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	return domain_error.PreconditionFailed("").WithCurrent(mapper.RoleRDTOFromRoleByIdSQLC(fiberCtx, current))
+}
@@ -0,0 +1,57 @@
+package trash_use_case
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/trash"
+	"context"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetLastPurgeInput struct {
+	// Table - trash.TableRoles или trash.TablePermissions, задается маршрутом
+	Table string
+}
+
+// GetLastPurgeUseCase возвращает итог последней очистки корзины для одной таблицы
+// Итог хранится в памяти процесса и сбрасывается при перезапуске
+type GetLastPurgeUseCase struct {
+	Purger *trash.Purger
+}
+
+func NewGetLastPurgeUseCase(purger *trash.Purger) *GetLastPurgeUseCase {
+	return &GetLastPurgeUseCase{Purger: purger}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetLastPurgeUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetLastPurgeInput) error {
+	if _, ok := (trash.Report{}).Table(input.Table); !ok {
+		return fmt.Errorf("unknown trash table %q", input.Table)
+	}
+	return nil
+}
+
+func (u *GetLastPurgeUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetLastPurgeInput) (*dto.PurgeRunRDTO, error) {
+	result, ok := u.Purger.LastRun()
+	if !ok {
+		return nil, domain_error.NotFound("trash.purge_not_run")
+	}
+	purged, _ := result.Report.Table(input.Table)
+	return &dto.PurgeRunRDTO{
+		Table:      input.Table,
+		StartedAt:  result.StartedAt,
+		FinishedAt: result.FinishedAt,
+		Retention:  result.Retention.String(),
+		Count:      purged.Count,
+		IDs:        purged.IDs,
+		Values:     purged.Values,
+		Failed:     result.Err != nil,
+	}, nil
+}
+
+func (u *GetLastPurgeUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.PurgeRunRDTO) (any, error) {
+	return result, nil
+}
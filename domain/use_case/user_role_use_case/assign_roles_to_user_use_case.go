@@ -0,0 +1,101 @@
+package user_role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ChangeUserRolesInput используется для назначения, снятия и замены ролей пользователя
+type ChangeUserRolesInput struct {
+	UserID string           `params:"id" validate:"required,uuid"`
+	Data   dto.UserRolesDTO `body:"true"`
+}
+
+// validate проверяет ID пользователя и ID ролей
+// allowEmpty разрешает пустой список (для замены - означает снятие всех ролей)
+func (input ChangeUserRolesInput) validate(allowEmpty bool) error {
+	if err := validation.Struct(input); err != nil {
+		return err
+	}
+	if !allowEmpty && len(input.Data.RoleIDs) == 0 {
+		return validation.Fields(validation.Field("role_ids", "required", ""))
+	}
+	return nil
+}
+
+// parse преобразует ID пользователя и ID ролей в pgtype.UUID
+// Список ролей никогда не возвращается как nil, чтобы unnest получил пустой массив, а не NULL
+func (input ChangeUserRolesInput) parse() (pgtype.UUID, []pgtype.UUID, error) {
+	userID, err := mapper.ParseUUID(input.UserID)
+	if err != nil {
+		return pgtype.UUID{}, nil, err
+	}
+	roleIDs, err := mapper.ParseUUIDs(input.Data.RoleIDs)
+	if err != nil {
+		return pgtype.UUID{}, nil, err
+	}
+	if roleIDs == nil {
+		roleIDs = []pgtype.UUID{}
+	}
+	return userID, roleIDs, nil
+}
+
+// AssignRolesToUserUseCase добавляет роли пользователю (уже назначенные пропускаются)
+type AssignRolesToUserUseCase struct {
+	Repo  repositories.UserRoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewAssignRolesToUserUseCase(repo repositories.UserRoleRepository, tx *db.TxManager, recorder *audit.Recorder) *AssignRolesToUserUseCase {
+	return &AssignRolesToUserUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *AssignRolesToUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) error {
+	return input.validate(false)
+}
+
+func (u *AssignRolesToUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) ([]dto.RoleRDTO, error) {
+	userID, roleIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var rolesSQLC []generated.Role
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if _, err := u.Repo.AssignRoles(ctx, userID, roleIDs); err != nil {
+			return err
+		}
+		if rolesSQLC, err = u.Repo.GetUserRoles(ctx, userID); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionAssign,
+			Entity:   audit.EntityUserRole,
+			EntityID: userID,
+			Before:   audit.RoleValues(before),
+			After:    audit.RoleValues(rolesSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromRolesSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *AssignRolesToUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package user_role_use_case
+
+import (
+	"clean_architecture_fiber/domain/authorization"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CheckUserHasPermissionInput struct {
+	UserID          string `params:"id" validate:"required,uuid"`
+	PermissionValue string `query:"permission" validate:"required"`
+}
+
+// CheckUserHasPermissionUseCase проверяет, дает ли какая-либо роль пользователя разрешение (по значению value)
+// Учитываются те же разрешения, что и в RequirePermission: manage и разрешения без ресурса (authorization.Grants)
+type CheckUserHasPermissionUseCase struct {
+	Repo repositories.UserRoleRepository
+}
+
+func NewCheckUserHasPermissionUseCase(repo repositories.UserRoleRepository) *CheckUserHasPermissionUseCase {
+	return &CheckUserHasPermissionUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *CheckUserHasPermissionUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input CheckUserHasPermissionInput) error {
+	return validation.Struct(input)
+}
+
+func (u *CheckUserHasPermissionUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input CheckUserHasPermissionInput) (*dto.UserPermissionCheckRDTO, error) {
+	userID, err := mapper.ParseUUID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.UserPermissionCheckRDTO{
+		UserID:     input.UserID,
+		Permission: input.PermissionValue,
+	}
+	for _, grant := range authorization.Grants(input.PermissionValue) {
+		hasPermission, err := u.Repo.HasPermission(ctx, userID, grant)
+		if err != nil {
+			return nil, err
+		}
+		if hasPermission {
+			result.HasPermission = true
+			break
+		}
+	}
+	return result, nil
+}
+
+func (u *CheckUserHasPermissionUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.UserPermissionCheckRDTO) (any, error) {
+	return result, nil
+}
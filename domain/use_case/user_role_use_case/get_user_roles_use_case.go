@@ -0,0 +1,45 @@
+package user_role_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUserRolesInput struct {
+	UserID string `params:"id" validate:"required,uuid"`
+}
+
+// GetUserRolesUseCase возвращает активные роли пользователя
+type GetUserRolesUseCase struct {
+	Repo repositories.UserRoleRepository
+}
+
+func NewGetUserRolesUseCase(repo repositories.UserRoleRepository) *GetUserRolesUseCase {
+	return &GetUserRolesUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetUserRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetUserRolesInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetUserRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetUserRolesInput) ([]dto.RoleRDTO, error) {
+	userID, err := mapper.ParseUUID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	rolesSQLC, err := u.Repo.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromRolesSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *GetUserRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package user_role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RemoveRolesFromUserUseCase снимает роли с пользователя (не назначенные пропускаются)
+type RemoveRolesFromUserUseCase struct {
+	Repo  repositories.UserRoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewRemoveRolesFromUserUseCase(repo repositories.UserRoleRepository, tx *db.TxManager, recorder *audit.Recorder) *RemoveRolesFromUserUseCase {
+	return &RemoveRolesFromUserUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *RemoveRolesFromUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) error {
+	return input.validate(false)
+}
+
+func (u *RemoveRolesFromUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) ([]dto.RoleRDTO, error) {
+	userID, roleIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var rolesSQLC []generated.Role
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if _, err := u.Repo.RemoveRoles(ctx, userID, roleIDs); err != nil {
+			return err
+		}
+		if rolesSQLC, err = u.Repo.GetUserRoles(ctx, userID); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionRemove,
+			Entity:   audit.EntityUserRole,
+			EntityID: userID,
+			Before:   audit.RoleValues(before),
+			After:    audit.RoleValues(rolesSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromRolesSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *RemoveRolesFromUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package user_role_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReplaceUserRolesUseCase заменяет весь набор ролей пользователя
+// Замена выполняется в одной транзакции, поэтому пользователь никогда не остается с частично обновленным набором
+type ReplaceUserRolesUseCase struct {
+	Repo  repositories.UserRoleRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewReplaceUserRolesUseCase(repo repositories.UserRoleRepository, tx *db.TxManager, recorder *audit.Recorder) *ReplaceUserRolesUseCase {
+	return &ReplaceUserRolesUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *ReplaceUserRolesUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) error {
+	return input.validate(true)
+}
+
+func (u *ReplaceUserRolesUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input ChangeUserRolesInput) ([]dto.RoleRDTO, error) {
+	userID, roleIDs, err := input.parse()
+	if err != nil {
+		return nil, err
+	}
+	var rolesSQLC []generated.Role
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if rolesSQLC, err = u.Repo.ReplaceRoles(ctx, userID, roleIDs); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionReplace,
+			Entity:   audit.EntityUserRole,
+			EntityID: userID,
+			Before:   audit.RoleValues(before),
+			After:    audit.RoleValues(rolesSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RoleRDTOListFromRolesSQLC(fiberCtx, rolesSQLC), nil
+}
+
+func (u *ReplaceUserRolesUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result []dto.RoleRDTO) (any, error) {
+	return result, nil
+}
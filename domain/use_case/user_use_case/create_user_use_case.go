@@ -0,0 +1,60 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"clean_architecture_fiber/pkg/auth"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateUserUseCase создает пользователя без ролей; роли назначаются через /users/:id/roles
+type CreateUserUseCase struct {
+	Repo  repositories.UserRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewCreateUserUseCase(repo repositories.UserRepository, tx *db.TxManager, recorder *audit.Recorder) *CreateUserUseCase {
+	return &CreateUserUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *CreateUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input dto.CreateUserDTO) error {
+	return validation.Struct(input)
+}
+
+func (u *CreateUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input dto.CreateUserDTO) (*dto.UserRDTO, error) {
+	passwordHash, err := auth.HashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+	var userSQLC *generated.User
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if userSQLC, err = u.Repo.Create(ctx, mapper.CreateOneUserParamsFromUserDTO(input, passwordHash)); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionCreate,
+			Entity:   audit.EntityUser,
+			EntityID: userSQLC.ID,
+			After:    audit.User(userSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := mapper.UserRDTOFromUserSQLC(*userSQLC, nil)
+	return &result, nil
+}
+
+func (u *CreateUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.UserRDTO) (any, error) {
+	return result, nil
+}
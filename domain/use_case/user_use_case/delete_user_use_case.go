@@ -0,0 +1,66 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteUserInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+// DeleteUserUseCase выполняет мягкое удаление пользователя (заполняет deleted_at)
+type DeleteUserUseCase struct {
+	Repo  repositories.UserRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewDeleteUserUseCase(repo repositories.UserRepository, tx *db.TxManager, recorder *audit.Recorder) *DeleteUserUseCase {
+	return &DeleteUserUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *DeleteUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input DeleteUserInput) error {
+	return validation.Struct(input)
+}
+
+func (u *DeleteUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input DeleteUserInput) (*dto.UserRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	var userSQLC *generated.User
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if userSQLC, err = u.Repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionDelete,
+			Entity:   audit.EntityUser,
+			EntityID: id,
+			Before:   audit.User(before),
+			After:    audit.User(userSQLC),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userRDTOWithRoles(ctx, u.Repo, userSQLC)
+}
+
+func (u *DeleteUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.UserRDTO) (any, error) {
+	return result, nil
+}
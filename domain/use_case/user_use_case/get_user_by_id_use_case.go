@@ -0,0 +1,44 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUserByIdInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+type GetUserByIdUseCase struct {
+	Repo repositories.UserRepository
+}
+
+func NewGetUserByIdUseCase(repo repositories.UserRepository) *GetUserByIdUseCase {
+	return &GetUserByIdUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *GetUserByIdUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input GetUserByIdInput) error {
+	return validation.Struct(input)
+}
+
+func (u *GetUserByIdUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input GetUserByIdInput) (*dto.UserRDTO, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	userSQLC, err := u.Repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return userRDTOWithRoles(ctx, u.Repo, userSQLC)
+}
+
+func (u *GetUserByIdUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *dto.UserRDTO) (any, error) {
+	return result, nil
+}
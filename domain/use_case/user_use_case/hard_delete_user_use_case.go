@@ -0,0 +1,68 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/data/db"
+	"clean_architecture_fiber/domain/audit"
+	"clean_architecture_fiber/domain/domain_error"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/domain/validation"
+	"context"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HardDeleteUserInput struct {
+	ID string `params:"id" validate:"required,uuid"`
+}
+
+// HardDeleteUserUseCase безвозвратно удаляет пользователя
+// Связи user_roles удаляются каскадно (ON DELETE CASCADE)
+type HardDeleteUserUseCase struct {
+	Repo  repositories.UserRepository
+	Tx    *db.TxManager
+	Audit *audit.Recorder
+}
+
+func NewHardDeleteUserUseCase(repo repositories.UserRepository, tx *db.TxManager, recorder *audit.Recorder) *HardDeleteUserUseCase {
+	return &HardDeleteUserUseCase{Repo: repo, Tx: tx, Audit: recorder}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *HardDeleteUserUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input HardDeleteUserInput) error {
+	return validation.Struct(input)
+}
+
+func (u *HardDeleteUserUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input HardDeleteUserInput) (bool, error) {
+	id, err := mapper.ParseUUID(input.ID)
+	if err != nil {
+		return false, err
+	}
+	err = u.Tx.Do(ctx, func(ctx context.Context) error {
+		before, err := u.Repo.Lock(ctx, id)
+		if domain_error.Is(err, domain_error.KindNotFound) {
+			// Пользователя уже нет: удаление идемпотентно, в журнал записывать нечего
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := u.Repo.HardDelete(ctx, id); err != nil {
+			return err
+		}
+		return u.Audit.Record(fiberCtx, ctx, audit.Entry{
+			Action:   audit.ActionHardDelete,
+			Entity:   audit.EntityUser,
+			EntityID: id,
+			Before:   audit.User(before),
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *HardDeleteUserUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result bool) (any, error) {
+	return fiber.Map{"deleted": result}, nil
+}
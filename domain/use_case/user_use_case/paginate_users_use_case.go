@@ -0,0 +1,74 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"clean_architecture_fiber/pkg/pagination"
+	"context"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UserPaginationOptions - допустимые параметры списка пользователей
+// Поля сортировки совпадают с ветками ORDER BY в sqlc запросе PaginateAllUsers
+var UserPaginationOptions = pagination.Options{
+	SortFields:    []string{"created_at", "updated_at", "email", "full_name"},
+	DefaultSortBy: "created_at",
+	Searchable:    true,
+	SoftDeletable: true,
+	Filters:       []string{"ids", "roles"},
+	UUIDFilters:   []string{"ids"},
+}
+
+type PaginateUsersUseCase struct {
+	Repo repositories.UserRepository
+}
+
+func NewPaginateUsersUseCase(repo repositories.UserRepository) *PaginateUsersUseCase {
+	return &PaginateUsersUseCase{Repo: repo}
+}
+
+// --- Реализация UseCase интерфейса ---
+
+func (u *PaginateUsersUseCase) Validate(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) error {
+	if input.IsCursorMode() {
+		return fmt.Errorf("cursor mode is not supported")
+	}
+	return input.Validate(UserPaginationOptions)
+}
+
+func (u *PaginateUsersUseCase) Execute(fiberCtx *fiber.Ctx, ctx context.Context, input pagination.Query) (*pagination.Page[dto.UserRDTO], error) {
+	usersSQLC, total, err := pagination.Fetch(ctx,
+		func(ctx context.Context) ([]generated.PaginateAllUsersRow, error) {
+			return u.Repo.Paginate(ctx, generated.PaginateAllUsersParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				Search:      input.SearchText(),
+				Ids:         input.UUIDs("ids"),
+				RoleValues:  input.Strings("roles"),
+				SortBy:      input.SortBy,
+				SortOrder:   input.SortOrder,
+				Offset:      input.Offset(),
+				Limit:       input.Limit(),
+			})
+		},
+		func(ctx context.Context) (int64, error) {
+			return u.Repo.Count(ctx, generated.CountAllUsersParams{
+				ShowDeleted: input.ShowDeletedBool(),
+				Search:      input.SearchText(),
+				Ids:         input.UUIDs("ids"),
+				RoleValues:  input.Strings("roles"),
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(mapper.UserRDTOListFromPaginateSQLC(usersSQLC), input, total), nil
+}
+
+func (u *PaginateUsersUseCase) Transform(fiberCtx *fiber.Ctx, ctx context.Context, result *pagination.Page[dto.UserRDTO]) (any, error) {
+	return result, nil
+}
@@ -0,0 +1,19 @@
+package user_use_case
+
+import (
+	"clean_architecture_fiber/data/db/generated"
+	"clean_architecture_fiber/domain/dto"
+	"clean_architecture_fiber/domain/mapper"
+	"clean_architecture_fiber/domain/repositories"
+	"context"
+)
+
+// userRDTOWithRoles дополняет пользователя значениями его активных ролей
+func userRDTOWithRoles(ctx context.Context, repo repositories.UserRepository, userSQLC *generated.User) (*dto.UserRDTO, error) {
+	roles, err := repo.GetRoleValues(ctx, userSQLC.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := mapper.UserRDTOFromUserSQLC(*userSQLC, roles)
+	return &result, nil
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// bodyFieldName - имя поля-обертки тела запроса (тег `body:"true"`), не попадает в путь поля ошибки
+const bodyFieldName = "$body"
+
+// slugPattern - формат value ролей и разрешений: строчные латинские буквы и цифры,
+// разделенные одиночными ".", "_" или "-" (например, "admin", "roles.edit")
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+
+// messageIDs сопоставляет правила validator с i18n ключами сообщений
+var messageIDs = map[string]string{
+	"required":  "validation.required",
+	"min":       "validation.min_length",
+	"max":       "validation.max_length",
+	"uuid":      "validation.invalid_uuid",
+	"slug":      "validation.slug",
+	"email":     "validation.email",
+	"unique":    "validation.unique",
+	"exists":    "validation.exists",
+	"deleted":   "validation.deleted",
+	"max_items": "validation.max_items",
+}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(fieldName)
+	if err := v.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		return slugPattern.MatchString(fl.Field().String())
+	}); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Struct проверяет структуру по тегам `validate`
+// Нарушения возвращаются одной ошибкой domain_error.KindValidation со списком ошибок по полям
+func Struct(s any) error {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain_error.Internal(err)
+	}
+
+	fields := make([]domain_error.FieldError, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		fields = append(fields, Field(fieldPath(fieldErr.Namespace()), fieldErr.Tag(), fieldErr.Param()))
+	}
+	return domain_error.Validation("").WithFields(fields)
+}
+
+// Field создает ошибку поля для правила, проверяемого вручную
+func Field(field string, rule string, param string) domain_error.FieldError {
+	messageID, ok := messageIDs[rule]
+	if !ok {
+		messageID = "validation.invalid"
+	}
+	return domain_error.FieldError{
+		Field:     field,
+		Rule:      rule,
+		MessageID: messageID,
+		TemplateData: map[string]interface{}{
+			"Field": field,
+			"Param": param,
+			"Min":   param,
+			"Max":   param,
+		},
+	}
+}
+
+// Fields возвращает ошибку валидации из списка ошибок полей
+func Fields(fields ...domain_error.FieldError) error {
+	return domain_error.Validation("").WithFields(fields)
+}
+
+// fieldName возвращает имя поля так, как его видит клиент: json, params или query тег
+func fieldName(field reflect.StructField) string {
+	if field.Tag.Get("body") == "true" {
+		return bodyFieldName
+	}
+	for _, tag := range []string{"json", "params", "query"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// fieldPath убирает из пути имя корневой структуры и обертку тела запроса: UpdateRoleInput.$body.value -> value
+func fieldPath(namespace string) string {
+	parts := strings.Split(namespace, ".")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts[1:] {
+		if part != bodyFieldName {
+			result = append(result, part)
+		}
+	}
+	return strings.Join(result, ".")
+}
@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"clean_architecture_fiber/domain/domain_error"
+	i18nPkg "clean_architecture_fiber/pkg/i18n"
+	"reflect"
+	"testing"
+)
+
+type testBody struct {
+	Value string `json:"value" validate:"required,slug,max=10"`
+	Title string `json:"title" validate:"min=3"`
+}
+
+type testInput struct {
+	ID    string   `params:"id" validate:"required,uuid"`
+	Limit int      `query:"limit" validate:"max=100"`
+	Data  testBody `body:"true"`
+}
+
+func TestStruct(t *testing.T) {
+	const id = "0b0a8f5e-5d5e-4d1c-9a51-2c9c3c1f4b11"
+
+	tests := []struct {
+		name  string
+		input any
+		want  []domain_error.FieldError
+	}{
+		{
+			name:  "valid",
+			input: testInput{ID: id, Limit: 10, Data: testBody{Value: "admin", Title: "Admin"}},
+		},
+		{
+			name:  "body wrapper is not part of the field path",
+			input: testInput{ID: id, Data: testBody{Value: "Not A Slug", Title: "ab"}},
+			want: []domain_error.FieldError{
+				Field("value", "slug", ""),
+				Field("title", "min", "3"),
+			},
+		},
+		{
+			name:  "params and query use their tags",
+			input: testInput{ID: "42", Limit: 1000, Data: testBody{Value: "admin", Title: "Admin"}},
+			want: []domain_error.FieldError{
+				Field("id", "uuid", ""),
+				Field("limit", "max", "100"),
+			},
+		},
+		{
+			name:  "body without wrapper",
+			input: testBody{Title: "Admin"},
+			want:  []domain_error.FieldError{Field("value", "required", "")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Struct(tt.input)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Struct() error = %v, want nil", err)
+				}
+				return
+			}
+
+			domainErr, ok := domain_error.As(err)
+			if !ok || domainErr.Kind != domain_error.KindValidation {
+				t.Fatalf("Struct() error = %v, want %s", err, domain_error.KindValidation)
+			}
+			if !reflect.DeepEqual(domainErr.Fields, tt.want) {
+				t.Errorf("Struct() fields = %+v, want %+v", domainErr.Fields, tt.want)
+			}
+		})
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		rule          string
+		wantMessageID string
+	}{
+		{rule: "required", wantMessageID: "validation.required"},
+		{rule: "min", wantMessageID: "validation.min_length"},
+		{rule: "uuid", wantMessageID: "validation.invalid_uuid"},
+		{rule: "oneof", wantMessageID: "validation.invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			field := Field("title", tt.rule, "3")
+			if field.Field != "title" || field.Rule != tt.rule || field.MessageID != tt.wantMessageID {
+				t.Errorf("Field() = %+v, want field title, rule %s, message %s", field, tt.rule, tt.wantMessageID)
+			}
+			if field.TemplateData["Field"] != "title" || field.TemplateData["Min"] != "3" || field.TemplateData["Max"] != "3" {
+				t.Errorf("Field() template data = %v", field.TemplateData)
+			}
+		})
+	}
+}
+
+func TestMessagesAreTranslated(t *testing.T) {
+	if err := i18nPkg.Init(); err != nil {
+		t.Fatalf("i18n.Init() error = %v", err)
+	}
+
+	ids := []string{"validation.invalid"}
+	for _, messageID := range messageIDs {
+		ids = append(ids, messageID)
+	}
+	for _, messageID := range ids {
+		for _, lang := range i18nPkg.SupportedLanguages {
+			got := i18nPkg.T(lang, messageID, map[string]interface{}{"Field": "title", "Min": "3", "Max": "3"})
+			if got == messageID {
+				t.Errorf("%s: no %s translation", messageID, lang)
+			}
+		}
+	}
+}
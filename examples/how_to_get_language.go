@@ -40,7 +40,7 @@ func HowToGetLanguage() {
 		// Получаем значение напрямую из Fiber контекста
 		lang, ok := c.Locals(i18nPkg.LanguageContextKey).(string)
 		if !ok || lang == "" {
-			lang = i18nPkg.DefaultLanguage
+			lang = i18nPkg.DefaultLanguage()
 		}
 
 		return c.JSON(fiber.Map{
@@ -143,5 +143,5 @@ func HowToGetLanguage() {
 	//    - i18nPkg.LangEn = "en" (Английский)
 	//    - i18nPkg.LangKk = "kk" (Казахский)
 	//
-	// 4. Если указан неподдерживаемый язык, используется DefaultLanguage (ru)
+	// 4. Если указан неподдерживаемый язык, используется DefaultLanguage() (ru)
 }
@@ -0,0 +1,26 @@
+package auth
+
+import "golang.org/x/crypto/bcrypt"
+
+// dummyHash используется, когда пользователь не найден,
+// чтобы время ответа не выдавало существование email
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+// HashPassword хеширует пароль bcrypt
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword сравнивает пароль с bcrypt хешем
+func CheckPassword(hash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// WastePasswordCheck выполняет сравнение с фиктивным хешем той же стоимости, что и CheckPassword
+func WastePasswordCheck(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+}
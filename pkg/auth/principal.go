@@ -0,0 +1,21 @@
+package auth
+
+import "github.com/gofiber/fiber/v2"
+
+// PrincipalContextKey - ключ для сохранения аутентифицированного пользователя в контексте Fiber
+const PrincipalContextKey = "principal"
+
+// Principal - аутентифицированный пользователь запроса
+// Собирается middleware аутентификации из БД по ID пользователя из токена
+type Principal struct {
+	UserID string
+	Email  string
+	Roles  []string
+}
+
+// GetPrincipal возвращает пользователя, сохраненного middleware аутентификации
+// ok == false, если запрос не прошел аутентификацию
+func GetPrincipal(c *fiber.Ctx) (*Principal, bool) {
+	principal, ok := c.Locals(PrincipalContextKey).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidToken возвращается для просроченных, подделанных или неподходящих по типу токенов
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenType - назначение токена: access для запросов к API, refresh для выпуска новой пары
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// Claims - полезная нагрузка JWT
+// Subject - ID пользователя; email и роли в токен не попадают и читаются из БД на каждый запрос,
+// чтобы снятие роли или блокировка пользователя не ждали истечения токена
+type Claims struct {
+	jwt.RegisteredClaims
+	Type TokenType `json:"typ"`
+}
+
+// TokenPair - выпущенные access и refresh токены со сроками действия
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// TokenManager выпускает и проверяет JWT (HS256)
+// Access и refresh токены подписываются разными секретами, поэтому refresh нельзя использовать вместо access
+type TokenManager struct {
+	issuer        string
+	accessSecret  []byte
+	refreshSecret []byte
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+}
+
+// NewTokenManager создает менеджер токенов
+func NewTokenManager(issuer string, accessSecret []byte, refreshSecret []byte, accessTTL time.Duration, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		issuer:        issuer,
+		accessSecret:  accessSecret,
+		refreshSecret: refreshSecret,
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// IssuePair выпускает новую пару токенов для пользователя с ID userID
+func (m *TokenManager) IssuePair(userID string) (*TokenPair, error) {
+	now := time.Now()
+	pair := &TokenPair{
+		AccessExpiresAt:  now.Add(m.accessTTL),
+		RefreshExpiresAt: now.Add(m.refreshTTL),
+	}
+
+	var err error
+	pair.AccessToken, err = m.sign(m.accessSecret, Claims{
+		RegisteredClaims: m.registeredClaims(userID, now, pair.AccessExpiresAt),
+		Type:             TokenTypeAccess,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	pair.RefreshToken, err = m.sign(m.refreshSecret, Claims{
+		RegisteredClaims: m.registeredClaims(userID, now, pair.RefreshExpiresAt),
+		Type:             TokenTypeRefresh,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign refresh token: %w", err)
+	}
+
+	return pair, nil
+}
+
+// ParseAccessToken проверяет access токен и возвращает ID пользователя
+func (m *TokenManager) ParseAccessToken(token string) (string, error) {
+	return m.parse(token, m.accessSecret, TokenTypeAccess)
+}
+
+// ParseRefreshToken проверяет refresh токен и возвращает ID пользователя
+func (m *TokenManager) ParseRefreshToken(token string) (string, error) {
+	return m.parse(token, m.refreshSecret, TokenTypeRefresh)
+}
+
+func (m *TokenManager) registeredClaims(subject string, issuedAt time.Time, expiresAt time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Issuer:    m.issuer,
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		NotBefore: jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+}
+
+func (m *TokenManager) sign(secret []byte, claims Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
+
+func (m *TokenManager) parse(token string, secret []byte, tokenType TokenType) (string, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims,
+		func(*jwt.Token) (interface{}, error) { return secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Type != tokenType || claims.Subject == "" {
+		return "", ErrInvalidToken
+	}
+
+	return claims.Subject, nil
+}
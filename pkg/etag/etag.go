@@ -0,0 +1,31 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Any - значение If-Match, совпадающее с любой существующей версией ресурса
+const Any = "*"
+
+// weakPrefix - префикс слабого ETag
+const weakPrefix = "W/"
+
+// FromTime строит ETag из времени последнего изменения ресурса (updated_at) с точностью до микросекунды
+func FromTime(t time.Time) string {
+	return `"` + strconv.FormatInt(t.UnixMicro(), 36) + `"`
+}
+
+// Matches проверяет заголовок If-Match: "*" или список ETag через запятую
+// Сравнение строгое (RFC 7232, 3.1): слабые ETag (W/) не совпадают ни с чем, кроме "*"
+func Matches(ifMatch, etag string) bool {
+	strong := !strings.HasPrefix(etag, weakPrefix)
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == Any || strong && candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package etag
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromTimeRoundTrip(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 30, 0, 123456000, time.UTC)
+	current := FromTime(updatedAt)
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{"same version", current, true},
+		{"same instant in another zone", FromTime(updatedAt.In(time.FixedZone("UTC+5", 5*3600))), true},
+		{"nanoseconds below precision", FromTime(updatedAt.Add(999 * time.Nanosecond)), true},
+		{"weak etag", "W/" + current, false},
+		{"weak etag in list", "W/" + current + ", \"abc\"", false},
+		{"any", Any, true},
+		{"in list", `"abc", ` + current, true},
+		{"next microsecond", FromTime(updatedAt.Add(time.Microsecond)), false},
+		{"older version", FromTime(updatedAt.Add(-time.Second)), false},
+		{"unquoted", current[1 : len(current)-1], false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Matches(tt.ifMatch, current); got != tt.want {
+				t.Errorf("Matches(%q, %q) = %t, want %t", tt.ifMatch, current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesWeakCurrent(t *testing.T) {
+	current := FromTime(time.Unix(1714566600, 0))
+	for _, ifMatch := range []string{current, "W/" + current} {
+		if Matches(ifMatch, "W/"+current) {
+			t.Errorf("Matches(%q) must not match a weak current ETag", ifMatch)
+		}
+	}
+	if !Matches(Any, "W/"+current) {
+		t.Errorf("Matches(%q) must match any current ETag", Any)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -20,13 +21,31 @@ var (
 	// Bundle содержит все переводы
 	Bundle *i18n.Bundle
 
-	// DefaultLanguage - язык по умолчанию
-	DefaultLanguage = LangRu
-
 	// SupportedLanguages - список поддерживаемых языков
 	SupportedLanguages = []string{LangRu, LangEn, LangKk}
 )
 
+// defaultLanguage - язык по умолчанию, может меняться на лету через SetDefaultLanguage
+var defaultLanguage atomic.Value
+
+func init() {
+	defaultLanguage.Store(LangRu)
+}
+
+// DefaultLanguage возвращает язык по умолчанию
+func DefaultLanguage() string {
+	return defaultLanguage.Load().(string)
+}
+
+// SetDefaultLanguage меняет язык по умолчанию, безопасно вызывать во время обработки запросов
+func SetDefaultLanguage(lang string) error {
+	if !IsLanguageSupported(lang) {
+		return fmt.Errorf("unsupported language %q", lang)
+	}
+	defaultLanguage.Store(lang)
+	return nil
+}
+
 //go:embed locales/*.json
 var localesFS embed.FS
 
@@ -59,14 +78,14 @@ func Init() error {
 // Если язык не поддерживается, используется язык по умолчанию
 func GetLocalizer(lang string) *i18n.Localizer {
 	if !IsLanguageSupported(lang) {
-		lang = DefaultLanguage
+		lang = DefaultLanguage()
 	}
 	return i18n.NewLocalizer(Bundle, lang)
 }
 
 // GetLocalizerFromAcceptLanguage создает локализатор на основе Accept-Language заголовка
 func GetLocalizerFromAcceptLanguage(acceptLanguage string) *i18n.Localizer {
-	return i18n.NewLocalizer(Bundle, acceptLanguage, DefaultLanguage)
+	return i18n.NewLocalizer(Bundle, acceptLanguage, DefaultLanguage())
 }
 
 // IsLanguageSupported проверяет, поддерживается ли указанный язык
@@ -99,5 +118,5 @@ func T(lang, messageID string, templateData map[string]interface{}) string {
 
 // TDefault переводит сообщение с дефолтным языком
 func TDefault(messageID string, templateData map[string]interface{}) string {
-	return T(DefaultLanguage, messageID, templateData)
+	return T(DefaultLanguage(), messageID, templateData)
 }
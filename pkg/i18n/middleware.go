@@ -32,7 +32,7 @@ func Middleware() fiber.Handler {
 			// Используем Accept-Language заголовок
 			acceptLanguage := c.Get("Accept-Language", "")
 			if acceptLanguage == "" {
-				detectedLang = DefaultLanguage
+				detectedLang = DefaultLanguage()
 			} else {
 				// Парсим Accept-Language и определяем язык
 				detectedLang = parseAcceptLanguage(acceptLanguage)
@@ -53,7 +53,7 @@ func Middleware() fiber.Handler {
 func GetLocalizerFromContext(c *fiber.Ctx) *i18n.Localizer {
 	localizer, ok := c.Locals(LocalizerContextKey).(*i18n.Localizer)
 	if !ok {
-		return GetLocalizer(DefaultLanguage)
+		return GetLocalizer(DefaultLanguage())
 	}
 	return localizer
 }
@@ -112,7 +112,7 @@ func MustTranslate(c *fiber.Ctx, messageID string, defaultMessage string, templa
 func GetLanguage(c *fiber.Ctx) string {
 	lang, ok := c.Locals(LanguageContextKey).(string)
 	if !ok || lang == "" {
-		return DefaultLanguage
+		return DefaultLanguage()
 	}
 	return lang
 }
@@ -121,7 +121,7 @@ func GetLanguage(c *fiber.Ctx) string {
 // Если поддерживаемый язык не найден, возвращает язык по умолчанию
 func parseAcceptLanguage(acceptLanguage string) string {
 	if acceptLanguage == "" {
-		return DefaultLanguage
+		return DefaultLanguage()
 	}
 
 	// Простой парсинг: берем первые два символа (код языка)
@@ -133,5 +133,5 @@ func parseAcceptLanguage(acceptLanguage string) string {
 		}
 	}
 
-	return DefaultLanguage
+	return DefaultLanguage()
 }
@@ -0,0 +1,184 @@
+package pagination
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CursorSortBy - единственная сортировка, поддерживаемая в режиме курсора: (created_at, id) DESC
+const CursorSortBy = "created_at"
+
+// ErrInvalidCursor возвращается, если курсор поврежден, подделан или не может быть разобран
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Direction - направление перехода от курсора
+type Direction string
+
+const (
+	// DirectionNext - следующая страница (более старые записи)
+	DirectionNext Direction = "next"
+	// DirectionPrev - предыдущая страница (более новые записи)
+	DirectionPrev Direction = "prev"
+)
+
+// Cursor - позиция в списке, отсортированном по (created_at, id) DESC
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+	Direction Direction `json:"d"`
+}
+
+// Timestamp возвращает created_at курсора для sqlc запроса (NULL для первой страницы)
+func (c *Cursor) Timestamp() pgtype.Timestamp {
+	if c == nil {
+		return pgtype.Timestamp{Valid: false}
+	}
+	return pgtype.Timestamp{Time: c.CreatedAt, Valid: true}
+}
+
+// UUID возвращает id курсора для sqlc запроса (NULL для первой страницы)
+func (c *Cursor) UUID() pgtype.UUID {
+	if c == nil {
+		return pgtype.UUID{Valid: false}
+	}
+	parsed, err := uuid.Parse(c.ID)
+	if err != nil {
+		return pgtype.UUID{Valid: false}
+	}
+	return pgtype.UUID{Bytes: parsed, Valid: true}
+}
+
+// CursorCodec кодирует курсоры в непрозрачную строку и подписывает их HMAC-SHA256
+// Клиент не может изменить курсор, не сломав подпись
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec создает кодек курсоров с указанным секретом подписи
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: secret}
+}
+
+// Encode сериализует курсор в строку вида base64(payload).base64(signature)
+func (cc *CursorCodec) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(cc.sign(encoded))
+}
+
+// Decode проверяет подпись и разбирает курсор
+func (cc *CursorCodec) Decode(token string) (*Cursor, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, cc.sign(encoded)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Direction != DirectionNext && cursor.Direction != DirectionPrev {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+func (cc *CursorCodec) sign(data string) []byte {
+	mac := hmac.New(sha256.New, cc.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// CursorPage - конверт ответа в режиме курсора
+// Общее количество не считается: именно COUNT и глубокий OFFSET делают offset-режим медленным
+type CursorPage[T any] struct {
+	Items      []T    `json:"items"`
+	PerPage    int32  `json:"per_page"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// SeekFunc выполняет keyset запрос: cursor == nil означает первую страницу
+type SeekFunc[T any] func(ctx context.Context, cursor *Cursor, limit int32) ([]T, error)
+
+// KeyFunc возвращает ключ (created_at, id) элемента для построения курсоров
+type KeyFunc[T any] func(item T) (time.Time, string)
+
+// Seek выполняет keyset запрос в нужном направлении и строит курсоры соседних страниц
+// after должен возвращать записи в порядке (created_at, id) DESC, before - в порядке ASC
+func Seek[T any](ctx context.Context, codec *CursorCodec, q Query, cursor *Cursor, after SeekFunc[T], before SeekFunc[T], key KeyFunc[T]) (*CursorPage[T], error) {
+	// Запрашиваем на одну запись больше, чтобы узнать, есть ли следующая страница
+	limit := q.PerPage + 1
+	page := &CursorPage[T]{PerPage: q.PerPage}
+
+	if cursor == nil || cursor.Direction == DirectionNext {
+		items, err := after(ctx, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		hasMore := int32(len(items)) > q.PerPage
+		if hasMore {
+			items = items[:q.PerPage]
+		}
+		page.Items = items
+		if hasMore {
+			page.NextCursor = cursorFor(codec, items[len(items)-1], key, DirectionNext)
+		}
+		if cursor != nil && len(items) > 0 {
+			page.PrevCursor = cursorFor(codec, items[0], key, DirectionPrev)
+		}
+	} else {
+		items, err := before(ctx, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		hasMore := int32(len(items)) > q.PerPage
+		if hasMore {
+			items = items[:q.PerPage]
+		}
+		// before возвращает записи по возрастанию - разворачиваем в общий порядок DESC
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		page.Items = items
+		if hasMore {
+			page.PrevCursor = cursorFor(codec, items[0], key, DirectionPrev)
+		}
+		if len(items) > 0 {
+			page.NextCursor = cursorFor(codec, items[len(items)-1], key, DirectionNext)
+		}
+	}
+
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	return page, nil
+}
+
+func cursorFor[T any](codec *CursorCodec, item T, key KeyFunc[T], direction Direction) string {
+	createdAt, id := key(item)
+	return codec.Encode(Cursor{CreatedAt: createdAt, ID: id, Direction: direction})
+}
@@ -0,0 +1,77 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorCodecRoundTrip(t *testing.T) {
+	codec := NewCursorCodec([]byte("secret"))
+	createdAt := time.Date(2024, 5, 1, 12, 30, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{"next", Cursor{CreatedAt: createdAt, ID: "0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d11", Direction: DirectionNext}},
+		{"prev", Cursor{CreatedAt: createdAt, ID: "0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d11", Direction: DirectionPrev}},
+		{"zero time", Cursor{ID: "00000000-0000-0000-0000-000000000000", Direction: DirectionNext}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := codec.Decode(codec.Encode(tt.cursor))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !decoded.CreatedAt.Equal(tt.cursor.CreatedAt) || decoded.ID != tt.cursor.ID || decoded.Direction != tt.cursor.Direction {
+				t.Errorf("Decode() = %+v, want %+v", *decoded, tt.cursor)
+			}
+			if !decoded.UUID().Valid || !decoded.Timestamp().Valid {
+				t.Errorf("UUID()/Timestamp() of decoded cursor must be valid")
+			}
+		})
+	}
+}
+
+func TestCursorCodecRejectsTampering(t *testing.T) {
+	codec := NewCursorCodec([]byte("secret"))
+	valid := codec.Encode(Cursor{
+		CreatedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		ID:        "0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d11",
+		Direction: DirectionNext,
+	})
+	payload, signature, _ := strings.Cut(valid, ".")
+
+	// signed подписывает произвольный payload, чтобы проверить разбор уже после подписи
+	signed := func(json string) string {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(json))
+		return encoded + "." + base64.RawURLEncoding.EncodeToString(codec.sign(encoded))
+	}
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2024-05-01T00:00:00Z","id":"0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d12","d":"next"}`))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"no separator", payload + signature},
+		{"forged payload", forged + "." + signature},
+		{"truncated signature", payload + "." + signature[:len(signature)-2]},
+		{"signature not base64", payload + ".!!!"},
+		{"other secret", NewCursorCodec([]byte("other")).Encode(Cursor{ID: "0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d11", Direction: DirectionNext})},
+		{"payload not json", signed("not json")},
+		{"unknown direction", signed(`{"t":"2024-05-01T00:00:00Z","id":"0b6a3e4e-6f0a-4f3b-9a43-6f7f2f1e5d11","d":"up"}`)},
+		{"invalid id", signed(`{"t":"2024-05-01T00:00:00Z","id":"42","d":"next"}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := codec.Decode(tt.token)
+			if !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Decode() = %+v, %v, want ErrInvalidCursor", cursor, err)
+			}
+		})
+	}
+}
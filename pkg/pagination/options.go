@@ -0,0 +1,85 @@
+package pagination
+
+// Направления сортировки, которые понимают sqlc запросы
+const (
+	SortOrderAsc  = "ASC"
+	SortOrderDesc = "DESC"
+)
+
+const (
+	// DefaultPerPage - размер страницы, если сущность не задала свой
+	DefaultPerPage int32 = 20
+	// MaxPerPage - максимальный размер страницы, если сущность не задала свой
+	MaxPerPage int32 = 100
+)
+
+// Options описывает, какие параметры списка допускает конкретная сущность (allow-list)
+//
+// Пример:
+//
+//	var RolePaginationOptions = pagination.Options{
+//	    SortFields:    []string{"created_at", "updated_at", "title_ru", "value"},
+//	    DefaultSortBy: "created_at",
+//	    Searchable:    true,
+//	    SoftDeletable: true,
+//	    Filters:       []string{"values", "ids"},
+//	    UUIDFilters:   []string{"ids"},
+//	}
+type Options struct {
+	// SortFields - поля, по которым разрешена сортировка (значения sort_by)
+	SortFields []string
+	// DefaultSortBy - поле сортировки по умолчанию
+	DefaultSortBy string
+	// DefaultSortOrder - направление сортировки по умолчанию (DESC, если не задано)
+	DefaultSortOrder string
+	// DefaultPerPage - размер страницы по умолчанию
+	DefaultPerPage int32
+	// MaxPerPage - максимальный размер страницы
+	MaxPerPage int32
+	// Searchable - поддерживает ли сущность параметр search
+	Searchable bool
+	// SoftDeletable - поддерживает ли сущность параметр show_deleted
+	SoftDeletable bool
+	// Filters - допустимые фильтры-массивы (?values[]=a&values[]=b)
+	Filters []string
+	// UUIDFilters - фильтры из Filters, значения которых должны быть UUID
+	UUIDFilters []string
+}
+
+func (o Options) defaultPerPage() int32 {
+	if o.DefaultPerPage > 0 {
+		return o.DefaultPerPage
+	}
+	return DefaultPerPage
+}
+
+func (o Options) maxPerPage() int32 {
+	if o.MaxPerPage > 0 {
+		return o.MaxPerPage
+	}
+	return MaxPerPage
+}
+
+func (o Options) defaultSortOrder() string {
+	if o.DefaultSortOrder != "" {
+		return o.DefaultSortOrder
+	}
+	return SortOrderDesc
+}
+
+func (o Options) allowsSort(field string) bool {
+	return contains(o.SortFields, field)
+}
+
+func (o Options) isUUIDFilter(name string) bool {
+	return contains(o.UUIDFilters, name)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package pagination
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Page - стандартный конверт постраничного ответа
+type Page[T any] struct {
+	Items      []T   `json:"items"`
+	Page       int32 `json:"page"`
+	PerPage    int32 `json:"per_page"`
+	Total      int64 `json:"total"`
+	TotalPages int32 `json:"total_pages"`
+}
+
+// NewPage собирает конверт страницы из элементов, параметров запроса и общего количества
+func NewPage[T any](items []T, q Query, total int64) *Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	var totalPages int32
+	if q.PerPage > 0 {
+		totalPages = int32((total + int64(q.PerPage) - 1) / int64(q.PerPage))
+	}
+
+	return &Page[T]{
+		Items:      items,
+		Page:       q.Page,
+		PerPage:    q.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// Fetch параллельно выполняет запрос страницы и запрос общего количества
+// Если любой из запросов завершится ошибкой, второй будет отменен через контекст
+func Fetch[T any](
+	ctx context.Context,
+	paginate func(ctx context.Context) ([]T, error),
+	count func(ctx context.Context) (int64, error),
+) ([]T, int64, error) {
+	var items []T
+	var total int64
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		var err error
+		items, err = paginate(groupCtx)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		total, err = count(groupCtx)
+		return err
+	})
+
+	if err := group.Wait(); err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
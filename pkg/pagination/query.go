@@ -0,0 +1,193 @@
+package pagination
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Query содержит параметры списка, прочитанные из query строки запроса
+// ?page=&per_page=&search=&sort_by=&sort_order=&values[]=&ids[]=&show_deleted=
+// В режиме курсора (?mode=cursor или ?cursor=) page игнорируется
+type Query struct {
+	Page        int32
+	PerPage     int32
+	Search      string
+	SortBy      string
+	SortOrder   string
+	ShowDeleted bool
+	OnlyDeleted bool
+	Filters     map[string][]string
+	Mode        string
+	Cursor      string
+}
+
+// ModeCursor - значение параметра mode для keyset пагинации
+const ModeCursor = "cursor"
+
+// Bind читает параметры списка из query строки и подставляет значения по умолчанию из opts
+// Читаются только фильтры, объявленные в opts.Filters
+// Bind не проверяет значения - для этого используется Validate
+func Bind(c *fiber.Ctx, opts Options) Query {
+	query := Query{
+		Page:        int32(c.QueryInt("page", 1)),
+		PerPage:     int32(c.QueryInt("per_page", int(opts.defaultPerPage()))),
+		Search:      strings.TrimSpace(c.Query("search")),
+		SortBy:      c.Query("sort_by", opts.DefaultSortBy),
+		SortOrder:   strings.ToUpper(c.Query("sort_order", opts.defaultSortOrder())),
+		ShowDeleted: c.QueryBool("show_deleted", false),
+		Filters:     make(map[string][]string, len(opts.Filters)),
+		Mode:        strings.ToLower(c.Query("mode")),
+		Cursor:      strings.TrimSpace(c.Query("cursor")),
+	}
+
+	for _, name := range opts.Filters {
+		if values := queryStrings(c, name); len(values) > 0 {
+			query.Filters[name] = values
+		}
+	}
+
+	return query
+}
+
+// TrashSortBy - сортировка корзины по умолчанию
+// Мягкое удаление обновляет updated_at, поэтому первыми идут недавно удаленные записи
+const TrashSortBy = "updated_at"
+
+// BindTrash читает параметры корзины: те же, что у Bind, но только мягко удаленные записи
+// OnlyDeleted не читается из query строки, его выставляет только BindTrash
+func BindTrash(c *fiber.Ctx, opts Options) Query {
+	opts.DefaultSortBy = TrashSortBy
+	query := Bind(c, opts)
+	query.ShowDeleted = true
+	query.OnlyDeleted = true
+	return query
+}
+
+// Validate проверяет параметры списка по allow-list сущности
+func (q Query) Validate(opts Options) error {
+	if q.Page < 1 {
+		return fmt.Errorf("page must be greater than 0")
+	}
+	if q.PerPage < 1 || q.PerPage > opts.maxPerPage() {
+		return fmt.Errorf("per_page must be between 1 and %d", opts.maxPerPage())
+	}
+	if q.SortBy != "" && !opts.allowsSort(q.SortBy) {
+		return fmt.Errorf("unsupported sort_by '%s', allowed: %s", q.SortBy, strings.Join(opts.SortFields, ", "))
+	}
+	if q.SortOrder != SortOrderAsc && q.SortOrder != SortOrderDesc {
+		return fmt.Errorf("unsupported sort_order '%s', allowed: %s, %s", q.SortOrder, SortOrderAsc, SortOrderDesc)
+	}
+	if q.Search != "" && !opts.Searchable {
+		return fmt.Errorf("search is not supported")
+	}
+	if q.ShowDeleted && !opts.SoftDeletable {
+		return fmt.Errorf("show_deleted is not supported")
+	}
+	if q.OnlyDeleted && !opts.SoftDeletable {
+		return fmt.Errorf("trash is not supported")
+	}
+	if q.IsCursorMode() && q.OnlyDeleted {
+		return fmt.Errorf("cursor mode is not supported for trash")
+	}
+	if q.IsCursorMode() && (q.SortBy != CursorSortBy || q.SortOrder != SortOrderDesc) {
+		return fmt.Errorf("cursor mode supports only sort_by=%s, sort_order=%s", CursorSortBy, SortOrderDesc)
+	}
+	for name, values := range q.Filters {
+		if !contains(opts.Filters, name) {
+			return fmt.Errorf("unsupported filter '%s'", name)
+		}
+		if !opts.isUUIDFilter(name) {
+			continue
+		}
+		for _, value := range values {
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("filter '%s' contains invalid uuid '%s'", name, value)
+			}
+		}
+	}
+	return nil
+}
+
+// IsCursorMode сообщает, запрошена ли keyset пагинация вместо offset
+func (q Query) IsCursorMode() bool {
+	return q.Mode == ModeCursor || q.Cursor != ""
+}
+
+// Limit возвращает размер страницы для LIMIT
+func (q Query) Limit() int32 {
+	return q.PerPage
+}
+
+// Offset возвращает смещение для OFFSET
+func (q Query) Offset() int32 {
+	return (q.Page - 1) * q.PerPage
+}
+
+// SearchText возвращает строку поиска как pgtype.Text (NULL, если поиск не задан)
+func (q Query) SearchText() pgtype.Text {
+	if q.Search == "" {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: q.Search, Valid: true}
+}
+
+// ShowDeletedBool возвращает флаг show_deleted как pgtype.Bool
+func (q Query) ShowDeletedBool() pgtype.Bool {
+	return pgtype.Bool{Bool: q.ShowDeleted, Valid: true}
+}
+
+// OnlyDeletedBool возвращает флаг корзины как pgtype.Bool
+func (q Query) OnlyDeletedBool() pgtype.Bool {
+	return pgtype.Bool{Bool: q.OnlyDeleted, Valid: true}
+}
+
+// Strings возвращает значения фильтра (nil - фильтр не применяется)
+func (q Query) Strings(name string) []string {
+	values := q.Filters[name]
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// UUIDs возвращает значения UUID-фильтра (nil - фильтр не применяется)
+// Невалидные значения пропускаются - их отсекает Validate
+func (q Query) UUIDs(name string) []pgtype.UUID {
+	values := q.Filters[name]
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]pgtype.UUID, 0, len(values))
+	for _, value := range values {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			continue
+		}
+		result = append(result, pgtype.UUID{Bytes: parsed, Valid: true})
+	}
+	return result
+}
+
+// queryStrings возвращает все значения query параметра
+// Поддерживаются формы ?key=a&key=b, ?key[]=a&key[]=b и ?key=a,b
+func queryStrings(c *fiber.Ctx, key string) []string {
+	var result []string
+	args := c.Context().QueryArgs()
+
+	for _, name := range []string{key, key + "[]"} {
+		for _, raw := range args.PeekMulti(name) {
+			for _, part := range strings.Split(string(raw), ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					result = append(result, part)
+				}
+			}
+		}
+	}
+
+	return result
+}